@@ -16,14 +16,14 @@ package clients
 
 import (
 	"context"
+
+	v2clients "github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
 )
 
 // FromContext allows for the retrieval of the specified key's value from the supplied Context.
+// Both the plain string key and the typed key of the v2 clients, e.g. the Correlation ID set by
+// v2clients.WithCorrelationID, are accepted, where the typed key takes precedence.
 // If the value is not found, an empty string is returned.
 func FromContext(ctx context.Context, key string) string {
-	hdr, ok := ctx.Value(key).(string)
-	if !ok {
-		hdr = ""
-	}
-	return hdr
+	return v2clients.ValueFromContext(ctx, key)
 }
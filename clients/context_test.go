@@ -0,0 +1,42 @@
+/*******************************************************************************
+ * Copyright 2021 IOTech Ltd
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License"); you may not use this file except
+ * in compliance with the License. You may obtain a copy of the License at
+ *
+ * http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software distributed under the License
+ * is distributed on an "AS IS" BASIS, WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express
+ * or implied. See the License for the specific language governing permissions and limitations under
+ * the License.
+ *******************************************************************************/
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	v2clients "github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+)
+
+func TestFromContext(t *testing.T) {
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{"plain string key", context.WithValue(context.Background(), CorrelationHeader, "plain"), "plain"},
+		{"v2 typed key", v2clients.WithCorrelationID(context.Background(), "typed"), "typed"},
+		{"typed key takes precedence", v2clients.WithCorrelationID(context.WithValue(context.Background(), CorrelationHeader, "plain"), "typed"), "typed"},
+		{"not found", context.Background(), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := FromContext(tt.ctx, CorrelationHeader); actual != tt.expected {
+				t.Errorf("expected %q but got %q", tt.expected, actual)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"crypto/sha256"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+// fingerprint identifies the value of a reading. The binary values are kept as checksums so that large payloads
+// such as images are not retained between the reads.
+type fingerprint struct {
+	valueType string
+	value     string
+	mediaType string
+	checksum  [sha256.Size]byte
+}
+
+// changeDetector remembers the last value of each resource of an AutoEvent
+type changeDetector struct {
+	last map[string]fingerprint
+}
+
+func newChangeDetector() *changeDetector {
+	return &changeDetector{last: make(map[string]fingerprint)}
+}
+
+// changed returns the readings whose value differs from the last read of the same resource
+func (c *changeDetector) changed(readings []dtos.BaseReading) []dtos.BaseReading {
+	var result []dtos.BaseReading
+	for _, r := range readings {
+		fp := fingerprintOf(r)
+		if last, ok := c.last[r.ResourceName]; ok && last == fp {
+			continue
+		}
+		c.last[r.ResourceName] = fp
+		result = append(result, r)
+	}
+	return result
+}
+
+func fingerprintOf(r dtos.BaseReading) fingerprint {
+	if r.ValueType == v2.ValueTypeBinary {
+		return fingerprint{valueType: r.ValueType, mediaType: r.MediaType, checksum: sha256.Sum256(r.BinaryValue)}
+	}
+	return fingerprint{valueType: r.ValueType, value: normalize(r.ValueType, r.Value)}
+}
+
+// normalize converts the string value to the canonical form of its value type, so that e.g. the Float32 values
+// "1.5" and "1.500000e+00" are the same. A value that can't be parsed is compared as is.
+func normalize(valueType string, value string) string {
+	switch valueType {
+	case v2.ValueTypeBool:
+		if b, err := strconv.ParseBool(value); err == nil {
+			return strconv.FormatBool(b)
+		}
+	case v2.ValueTypeInt8, v2.ValueTypeInt16, v2.ValueTypeInt32, v2.ValueTypeInt64:
+		if i, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return strconv.FormatInt(i, 10)
+		}
+	case v2.ValueTypeUint8, v2.ValueTypeUint16, v2.ValueTypeUint32, v2.ValueTypeUint64:
+		if u, err := strconv.ParseUint(value, 10, 64); err == nil {
+			return strconv.FormatUint(u, 10)
+		}
+	case v2.ValueTypeFloat32:
+		if f, err := strconv.ParseFloat(value, 32); err == nil {
+			return strconv.FormatFloat(f, 'g', -1, 32)
+		}
+	case v2.ValueTypeFloat64:
+		if f, err := strconv.ParseFloat(value, 64); err == nil {
+			return strconv.FormatFloat(f, 'g', -1, 64)
+		}
+	case v2.ValueTypeString:
+		return value
+	default:
+		if strings.HasSuffix(valueType, "Array") {
+			return normalizeArray(strings.TrimSuffix(valueType, "Array"), value)
+		}
+	}
+	return value
+}
+
+// normalizeArray normalizes the elements of an array value such as "[1, 2, 3]"
+func normalizeArray(elementType string, value string) string {
+	trimmed := strings.TrimSpace(value)
+	if elementType == v2.ValueTypeString || !strings.HasPrefix(trimmed, "[") || !strings.HasSuffix(trimmed, "]") {
+		return value
+	}
+	elements := strings.FieldsFunc(trimmed[1:len(trimmed)-1], func(r rune) bool { return r == ',' || r == ' ' })
+	for i, e := range elements {
+		elements[i] = normalize(elementType, e)
+	}
+	return "[" + strings.Join(elements, ",") + "]"
+}
@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+func simpleReading(resourceName string, valueType string, value string) dtos.BaseReading {
+	return dtos.BaseReading{ResourceName: resourceName, ValueType: valueType, SimpleReading: dtos.SimpleReading{Value: value}}
+}
+
+func binaryReading(resourceName string, value []byte) dtos.BaseReading {
+	return dtos.BaseReading{ResourceName: resourceName, ValueType: v2.ValueTypeBinary,
+		BinaryReading: dtos.BinaryReading{BinaryValue: value, MediaType: "application/octet-stream"}}
+}
+
+func TestChangeDetector(t *testing.T) {
+	tests := []struct {
+		name     string
+		first    dtos.BaseReading
+		second   dtos.BaseReading
+		expected bool
+	}{
+		{"same int", simpleReading("r", v2.ValueTypeInt32, "25"), simpleReading("r", v2.ValueTypeInt32, "25"), false},
+		{"changed int", simpleReading("r", v2.ValueTypeInt32, "25"), simpleReading("r", v2.ValueTypeInt32, "26"), true},
+		{"same float in different formats", simpleReading("r", v2.ValueTypeFloat32, "1.500000e+00"), simpleReading("r", v2.ValueTypeFloat32, "1.5"), false},
+		{"changed float", simpleReading("r", v2.ValueTypeFloat64, "1.500000e+00"), simpleReading("r", v2.ValueTypeFloat64, "1.6"), true},
+		{"same bool in different formats", simpleReading("r", v2.ValueTypeBool, "true"), simpleReading("r", v2.ValueTypeBool, "TRUE"), false},
+		{"same array in different formats", simpleReading("r", v2.ValueTypeFloat32Array, "[1.000000e+00, 2.000000e+00]"), simpleReading("r", v2.ValueTypeFloat32Array, "[1,2]"), false},
+		{"changed array", simpleReading("r", v2.ValueTypeInt8Array, "[1, 2]"), simpleReading("r", v2.ValueTypeInt8Array, "[1, 3]"), true},
+		{"changed value type", simpleReading("r", v2.ValueTypeInt32, "1"), simpleReading("r", v2.ValueTypeInt64, "1"), true},
+		{"same string", simpleReading("r", v2.ValueTypeString, "on"), simpleReading("r", v2.ValueTypeString, "on"), false},
+		{"same binary", binaryReading("r", []byte{1, 2, 3}), binaryReading("r", []byte{1, 2, 3}), false},
+		{"changed binary", binaryReading("r", []byte{1, 2, 3}), binaryReading("r", []byte{1, 2, 4}), true},
+		{"another resource", simpleReading("r", v2.ValueTypeInt32, "25"), simpleReading("other", v2.ValueTypeInt32, "25"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := newChangeDetector()
+			assert.Len(t, d.changed([]dtos.BaseReading{tt.first}), 1)
+			changed := d.changed([]dtos.BaseReading{tt.second})
+			if tt.expected {
+				assert.Equal(t, []dtos.BaseReading{tt.second}, changed)
+			} else {
+				assert.Empty(t, changed)
+			}
+		})
+	}
+}
+
+func TestChangeDetector_MultipleReadings(t *testing.T) {
+	d := newChangeDetector()
+	first := []dtos.BaseReading{simpleReading("a", v2.ValueTypeInt32, "1"), simpleReading("b", v2.ValueTypeInt32, "1")}
+	assert.Len(t, d.changed(first), 2)
+
+	second := []dtos.BaseReading{simpleReading("a", v2.ValueTypeInt32, "1"), simpleReading("b", v2.ValueTypeInt32, "2")}
+	assert.Equal(t, second[1:], d.changed(second))
+}
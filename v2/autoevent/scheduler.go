@@ -0,0 +1,169 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package autoevent schedules the AutoEvents of the devices, so that the device services share the same timer loop
+// and the same onChange detection instead of re-implementing them.
+package autoevent
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+// ReadFunc reads the device resource or device command named sourceName from the device
+type ReadFunc func(ctx context.Context, device models.Device, sourceName string) ([]dtos.BaseReading, errors.EdgeX)
+
+// HandleFunc receives the readings of an AutoEvent. If the AutoEvent is OnChange, the unchanged readings have been
+// removed and HandleFunc is not called at all when none of the readings changed.
+type HandleFunc func(device models.Device, autoEvent models.AutoEvent, readings []dtos.BaseReading)
+
+// ErrorFunc receives the errors returned by the ReadFunc
+type ErrorFunc func(device models.Device, autoEvent models.AutoEvent, err errors.EdgeX)
+
+// Scheduler fires the reads of the AutoEvents at their intervals. Each AutoEvent of a device runs in its own
+// goroutine until the device is removed or updated, or the context passed to Start is done. The AutoEvents of a
+// LOCKED device are not scheduled. A Scheduler is safe for concurrent use.
+type Scheduler struct {
+	read    ReadFunc
+	handle  HandleFunc
+	onError ErrorFunc
+
+	mutex   sync.Mutex
+	ctx     context.Context
+	devices map[string]context.CancelFunc
+	wg      sync.WaitGroup
+}
+
+// NewScheduler creates a Scheduler with the reader and handler callbacks, onError may be nil
+func NewScheduler(read ReadFunc, handle HandleFunc, onError ErrorFunc) *Scheduler {
+	return &Scheduler{
+		read:    read,
+		handle:  handle,
+		onError: onError,
+		devices: make(map[string]context.CancelFunc),
+	}
+}
+
+// Start schedules the AutoEvents of the devices, and stops all of them once ctx is done. Start returns an error
+// without scheduling anything if any AutoEvent has an invalid interval.
+func (s *Scheduler) Start(ctx context.Context, devices []models.Device) errors.EdgeX {
+	for _, d := range devices {
+		if err := validate(d); err != nil {
+			return err
+		}
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.ctx != nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "the AutoEvent scheduler has already been started", nil)
+	}
+	s.ctx = ctx
+	for _, d := range devices {
+		s.schedule(d)
+	}
+	return nil
+}
+
+// Update reschedules the AutoEvents of the device, e.g. after its AutoEvents or AdminState changed. The onChange
+// detection starts over with the rescheduled AutoEvents.
+func (s *Scheduler) Update(device models.Device) errors.EdgeX {
+	if err := validate(device); err != nil {
+		return err
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if s.ctx == nil {
+		return errors.NewCommonEdgeX(errors.KindServerError, "the AutoEvent scheduler has not been started", nil)
+	}
+	s.stop(device.Name)
+	s.schedule(device)
+	return nil
+}
+
+// Remove stops the AutoEvents of the device
+func (s *Scheduler) Remove(deviceName string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.stop(deviceName)
+}
+
+// Wait blocks until all the AutoEvent goroutines exited, i.e. after the context passed to Start is done
+func (s *Scheduler) Wait() {
+	s.wg.Wait()
+}
+
+func (s *Scheduler) schedule(device models.Device) {
+	if device.AdminState == models.Locked || len(device.AutoEvents) == 0 || s.ctx.Err() != nil {
+		return
+	}
+	ctx, cancel := context.WithCancel(s.ctx)
+	s.devices[device.Name] = cancel
+	for _, autoEvent := range device.AutoEvents {
+		// The interval has been validated
+		interval, _ := time.ParseDuration(autoEvent.Interval)
+		s.wg.Add(1)
+		go s.run(ctx, device, autoEvent, interval)
+	}
+}
+
+func (s *Scheduler) stop(deviceName string) {
+	if cancel, ok := s.devices[deviceName]; ok {
+		cancel()
+		delete(s.devices, deviceName)
+	}
+}
+
+func (s *Scheduler) run(ctx context.Context, device models.Device, autoEvent models.AutoEvent, interval time.Duration) {
+	defer s.wg.Done()
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	detector := newChangeDetector()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		readings, err := s.read(ctx, device, autoEvent.SourceName)
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			if s.onError != nil {
+				s.onError(device, autoEvent, err)
+			}
+			continue
+		}
+		if autoEvent.OnChange {
+			readings = detector.changed(readings)
+		}
+		if len(readings) > 0 {
+			s.handle(device, autoEvent, readings)
+		}
+	}
+}
+
+func validate(device models.Device) errors.EdgeX {
+	for _, autoEvent := range device.AutoEvents {
+		interval, err := time.ParseDuration(autoEvent.Interval)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid interval %s of the AutoEvent %s of the device %s", autoEvent.Interval, autoEvent.SourceName, device.Name), err)
+		}
+		if interval <= 0 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("the interval of the AutoEvent %s of the device %s should be positive", autoEvent.SourceName, device.Name), nil)
+		}
+	}
+	return nil
+}
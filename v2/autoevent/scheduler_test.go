@@ -0,0 +1,153 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package autoevent
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+const testInterval = "5ms"
+
+type recorder struct {
+	mutex    sync.Mutex
+	readings map[string][]dtos.BaseReading
+	errs     int
+}
+
+func newRecorder() *recorder {
+	return &recorder{readings: make(map[string][]dtos.BaseReading)}
+}
+
+func (r *recorder) handle(device models.Device, _ models.AutoEvent, readings []dtos.BaseReading) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.readings[device.Name] = append(r.readings[device.Name], readings...)
+}
+
+func (r *recorder) onError(models.Device, models.AutoEvent, errors.EdgeX) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	r.errs++
+}
+
+func (r *recorder) count(deviceName string) int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+	return len(r.readings[deviceName])
+}
+
+func testDevice(name string, onChange bool) models.Device {
+	return models.Device{
+		Name:       name,
+		AdminState: models.Unlocked,
+		AutoEvents: []models.AutoEvent{{Interval: testInterval, OnChange: onChange, SourceName: "temperature"}},
+	}
+}
+
+func constantReader(ctx context.Context, device models.Device, sourceName string) ([]dtos.BaseReading, errors.EdgeX) {
+	reading, _ := dtos.NewSimpleReading("profile", device.Name, sourceName, v2.ValueTypeInt32, int32(25))
+	return []dtos.BaseReading{reading}, nil
+}
+
+func TestScheduler(t *testing.T) {
+	r := newRecorder()
+	s := NewScheduler(constantReader, r.handle, r.onError)
+	ctx, cancel := context.WithCancel(context.Background())
+
+	locked := testDevice("locked", false)
+	locked.AdminState = models.Locked
+	err := s.Start(ctx, []models.Device{testDevice("periodic", false), testDevice("onChange", true), locked})
+	require.NoError(t, err)
+
+	require.Eventually(t, func() bool { return r.count("periodic") >= 3 }, time.Second, time.Millisecond)
+	assert.Equal(t, 1, r.count("onChange"), "unchanged readings should be suppressed")
+	assert.Equal(t, 0, r.count("locked"))
+
+	// Unlocking the device schedules its AutoEvents
+	locked.AdminState = models.Unlocked
+	require.NoError(t, s.Update(locked))
+	require.Eventually(t, func() bool { return r.count("locked") > 0 }, time.Second, time.Millisecond)
+
+	cancel()
+	done := make(chan struct{})
+	go func() {
+		s.Wait()
+		close(done)
+	}()
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("the AutoEvents did not stop after the context is done")
+	}
+	assert.Equal(t, 0, r.errs)
+}
+
+func TestScheduler_Remove(t *testing.T) {
+	r := newRecorder()
+	s := NewScheduler(constantReader, r.handle, nil)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx, []models.Device{testDevice("device", false)}))
+	require.Eventually(t, func() bool { return r.count("device") > 0 }, time.Second, time.Millisecond)
+
+	s.Remove("device")
+	count := r.count("device")
+	time.Sleep(30 * time.Millisecond)
+	// At most one read may have been in flight while removing
+	assert.LessOrEqual(t, r.count("device"), count+1)
+}
+
+func TestScheduler_ReadError(t *testing.T) {
+	r := newRecorder()
+	read := func(context.Context, models.Device, string) ([]dtos.BaseReading, errors.EdgeX) {
+		return nil, errors.NewCommonEdgeX(errors.KindServerError, "read failed", nil)
+	}
+	s := NewScheduler(read, r.handle, r.onError)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx, []models.Device{testDevice("device", false)}))
+	require.Eventually(t, func() bool {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+		return r.errs > 0
+	}, time.Second, time.Millisecond)
+	assert.Equal(t, 0, r.count("device"))
+}
+
+func TestScheduler_InvalidInterval(t *testing.T) {
+	invalid := testDevice("device", false)
+	invalid.AutoEvents[0].Interval = "10"
+	negative := testDevice("device", false)
+	negative.AutoEvents[0].Interval = "-1s"
+
+	for _, d := range []models.Device{invalid, negative} {
+		s := NewScheduler(constantReader, newRecorder().handle, nil)
+		err := s.Start(context.Background(), []models.Device{d})
+		require.Error(t, err)
+		assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	}
+}
+
+func TestScheduler_NotStarted(t *testing.T) {
+	s := NewScheduler(constantReader, newRecorder().handle, nil)
+	require.Error(t, s.Update(testDevice("device", false)))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	require.NoError(t, s.Start(ctx, nil))
+	require.Error(t, s.Start(ctx, nil))
+}
@@ -12,6 +12,9 @@ const (
 // Constants related to the possible content types supported by the APIs
 const (
 	ContentType     = "Content-Type"
+	Accept          = "Accept"
 	ContentTypeCBOR = "application/cbor"
 	ContentTypeJSON = "application/json"
+	ContentTypeText = "text/plain"
+	ContentTypeHTML = "text/html"
 )
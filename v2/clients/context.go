@@ -0,0 +1,48 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+)
+
+// ContextKey is the type of the context keys defined by this package. Unlike the plain string keys, the typed keys
+// don't collide with the context keys of other packages.
+type ContextKey string
+
+// Constants related to the context keys
+const (
+	CorrelationIDKey = ContextKey(CorrelationHeader) // Sets the key of the Correlation ID in the context
+	ContentTypeKey   = ContextKey(ContentType)       // Sets the key of the request content type in the context
+)
+
+// WithCorrelationID returns a copy of the context carrying the Correlation ID
+func WithCorrelationID(ctx context.Context, correlationId string) context.Context {
+	return context.WithValue(ctx, CorrelationIDKey, correlationId)
+}
+
+// CorrelationIDFrom returns the Correlation ID carried by the context, or an empty string if not found
+func CorrelationIDFrom(ctx context.Context) string {
+	return ValueFromContext(ctx, string(CorrelationIDKey))
+}
+
+// WithContentType returns a copy of the context carrying the content type of the request
+func WithContentType(ctx context.Context, contentType string) context.Context {
+	return context.WithValue(ctx, ContentTypeKey, contentType)
+}
+
+// ValueFromContext returns the string value of the key from the context, or an empty string if not found. The value
+// stored with the typed ContextKey takes precedence, and the value stored with the plain string key is still
+// accepted for backward compatibility.
+func ValueFromContext(ctx context.Context, key string) string {
+	if v, ok := ctx.Value(ContextKey(key)).(string); ok {
+		return v
+	}
+	if v, ok := ctx.Value(key).(string); ok {
+		return v
+	}
+	return ""
+}
@@ -0,0 +1,39 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValueFromContext(t *testing.T) {
+	legacyCtx := context.WithValue(context.Background(), CorrelationHeader, "legacy")
+	tests := []struct {
+		name     string
+		ctx      context.Context
+		expected string
+	}{
+		{"not found", context.Background(), ""},
+		{"typed key", WithCorrelationID(context.Background(), "typed"), "typed"},
+		{"plain string key", legacyCtx, "legacy"},
+		{"typed key takes precedence", WithCorrelationID(legacyCtx, "typed"), "typed"},
+		{"non-string value", context.WithValue(context.Background(), CorrelationIDKey, 1), ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, CorrelationIDFrom(tt.ctx))
+			assert.Equal(t, tt.expected, ValueFromContext(tt.ctx, CorrelationHeader))
+		})
+	}
+}
+
+func TestWithContentType(t *testing.T) {
+	ctx := WithContentType(context.Background(), ContentTypeCBOR)
+	assert.Equal(t, ContentTypeCBOR, ValueFromContext(ctx, ContentType))
+}
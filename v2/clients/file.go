@@ -0,0 +1,16 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package clients
+
+import "io"
+
+// File is a named content to be uploaded, such as a device profile in YAML format. The Content is closed after
+// uploading if it's an io.Closer, and the Contents of the files passed to a batch upload are all closed when the upload
+// returns, even if it's canceled before uploading them.
+type File struct {
+	Name    string
+	Content io.Reader
+}
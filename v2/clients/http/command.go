@@ -8,7 +8,6 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
@@ -46,8 +45,11 @@ func (client *CommandClient) AllDeviceCoreCommands(ctx context.Context, offset i
 // DeviceCoreCommandsByDeviceName returns all commands associated with the specified device name.
 func (client *CommandClient) DeviceCoreCommandsByDeviceName(ctx context.Context, name string) (
 	res responses.DeviceCoreCommandResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiDeviceRoute, v2.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -59,7 +61,10 @@ func (client *CommandClient) IssueGetCommandByName(ctx context.Context, deviceNa
 	requestParams := url.Values{}
 	requestParams.Set(v2.PushEvent, dsPushEvent)
 	requestParams.Set(v2.ReturnEvent, dsReturnEvent)
-	requestPath := path.Join(v2.ApiDeviceRoute, v2.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceNameCommandNameRoute).SetParam(v2.Name, deviceName).SetParam(v2.Command, commandName).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
@@ -69,7 +74,10 @@ func (client *CommandClient) IssueGetCommandByName(ctx context.Context, deviceNa
 
 // IssueSetCommandByName issues the specified write command referenced by the command name to the device/sensor that is also referenced by name.
 func (client *CommandClient) IssueSetCommandByName(ctx context.Context, deviceName string, commandName string, settings map[string]string) (res common.BaseResponse, err errors.EdgeX) {
-	requestPath := path.Join(v2.ApiDeviceRoute, v2.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceNameCommandNameRoute).SetParam(v2.Name, deviceName).SetParam(v2.Command, commandName).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	err = utils.PutRequest(ctx, &res, client.baseUrl+requestPath, settings)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
@@ -12,4 +12,6 @@ const (
 	TestHost               = "localhost"
 	TestPort               = 48089
 	TestHTTPMethod         = "GET"
+	TestSubscriptionName   = "TestSubscriptionName"
+	TestReceiver           = "TestReceiver"
 )
@@ -3,7 +3,6 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 	"strings"
 
@@ -58,8 +57,11 @@ func (dc DeviceClient) AllDevices(ctx context.Context, labels []string, offset i
 }
 
 func (dc DeviceClient) DeviceNameExists(ctx context.Context, name string) (res common.BaseResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiDeviceRoute, v2.Check, v2.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, dc.baseUrl, path, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceNameExistsRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -67,8 +69,11 @@ func (dc DeviceClient) DeviceNameExists(ctx context.Context, name string) (res c
 }
 
 func (dc DeviceClient) DeviceByName(ctx context.Context, name string) (res responses.DeviceResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiDeviceRoute, v2.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, dc.baseUrl, path, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dc.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -76,8 +81,11 @@ func (dc DeviceClient) DeviceByName(ctx context.Context, name string) (res respo
 }
 
 func (dc DeviceClient) DeleteDeviceByName(ctx context.Context, name string) (res common.BaseResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiDeviceRoute, v2.Name, url.QueryEscape(name))
-	err = utils.DeleteRequest(ctx, &res, dc.baseUrl, path)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, dc.baseUrl, requestPath)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -85,7 +93,10 @@ func (dc DeviceClient) DeleteDeviceByName(ctx context.Context, name string) (res
 }
 
 func (dc DeviceClient) DevicesByProfileName(ctx context.Context, name string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
-	requestPath := path.Join(v2.ApiDeviceRoute, v2.Profile, v2.Name, url.QueryEscape(name))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceByProfileNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
@@ -97,7 +108,10 @@ func (dc DeviceClient) DevicesByProfileName(ctx context.Context, name string, of
 }
 
 func (dc DeviceClient) DevicesByServiceName(ctx context.Context, name string, offset int, limit int) (res responses.MultiDevicesResponse, err errors.EdgeX) {
-	requestPath := path.Join(v2.ApiDeviceRoute, v2.Service, v2.Name, url.QueryEscape(name))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceByServiceNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
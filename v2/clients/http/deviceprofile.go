@@ -6,16 +6,18 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"fmt"
+	"io"
 	"net/url"
-	"path"
 	"strconv"
 	"strings"
 	"sync"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/http/utils"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/interfaces"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
@@ -77,11 +79,96 @@ func (client *DeviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePat
 	return responses, nil
 }
 
+// AddByYamlReader adds new device profile by uploading the yaml content read from the reader
+func (client *DeviceProfileClient) AddByYamlReader(ctx context.Context, fileName string, content io.Reader) (common.BaseWithIdResponse, errors.EdgeX) {
+	var response common.BaseWithIdResponse
+	err := utils.PostByReaderRequest(ctx, &response, client.baseUrl+v2.ApiDeviceProfileUploadFileRoute, fileName, content)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
+
+// UpdateByYamlReader updates device profile by uploading the yaml content read from the reader
+func (client *DeviceProfileClient) UpdateByYamlReader(ctx context.Context, fileName string, content io.Reader) (common.BaseResponse, errors.EdgeX) {
+	var response common.BaseResponse
+	err := utils.PutByReaderRequest(ctx, &response, client.baseUrl+v2.ApiDeviceProfileUploadFileRoute, fileName, content)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
+
+// AddByYamlBytes adds new device profile by uploading the yaml content
+func (client *DeviceProfileClient) AddByYamlBytes(ctx context.Context, fileName string, content []byte) (common.BaseWithIdResponse, errors.EdgeX) {
+	return client.AddByYamlReader(ctx, fileName, bytes.NewReader(content))
+}
+
+// UpdateByYamlBytes updates device profile by uploading the yaml content
+func (client *DeviceProfileClient) UpdateByYamlBytes(ctx context.Context, fileName string, content []byte) (common.BaseResponse, errors.EdgeX) {
+	return client.UpdateByYamlReader(ctx, fileName, bytes.NewReader(content))
+}
+
+// AddByYamlFiles adds new device profiles by uploading the yaml files one by one. A failed upload doesn't stop the
+// others, and its response carries the status code and the message of the error instead. An error is returned only
+// if the context is done before all the files are uploaded. The contents of all the files are closed on return,
+// including the ones not uploaded.
+func (client *DeviceProfileClient) AddByYamlFiles(ctx context.Context, files []clients.File) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	defer closeFiles(files)
+	responses := make([]common.BaseWithIdResponse, 0, len(files))
+	for _, f := range files {
+		if ctx.Err() != nil {
+			return responses, errors.NewCommonEdgeX(errors.KindClientError, "uploading the device profiles is canceled", ctx.Err())
+		}
+		response, err := client.AddByYamlReader(ctx, f.Name, f.Content)
+		if err != nil {
+			response = common.NewBaseWithIdResponse("", uploadErrorMessage(f.Name, err), err.Code(), "")
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// UpdateByYamlFiles updates device profiles by uploading the yaml files one by one, with the same semantics as
+// AddByYamlFiles
+func (client *DeviceProfileClient) UpdateByYamlFiles(ctx context.Context, files []clients.File) ([]common.BaseResponse, errors.EdgeX) {
+	defer closeFiles(files)
+	responses := make([]common.BaseResponse, 0, len(files))
+	for _, f := range files {
+		if ctx.Err() != nil {
+			return responses, errors.NewCommonEdgeX(errors.KindClientError, "uploading the device profiles is canceled", ctx.Err())
+		}
+		response, err := client.UpdateByYamlReader(ctx, f.Name, f.Content)
+		if err != nil {
+			response = common.NewBaseResponse("", uploadErrorMessage(f.Name, err), err.Code())
+		}
+		responses = append(responses, response)
+	}
+	return responses, nil
+}
+
+// closeFiles closes the contents of the files which are io.Closers. A content already closed after its upload is closed
+// again, whose error is ignored.
+func closeFiles(files []clients.File) {
+	for _, f := range files {
+		if closer, ok := f.Content.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+}
+
+func uploadErrorMessage(fileName string, err errors.EdgeX) string {
+	return fmt.Sprintf("failed to upload the file %s: %s", fileName, err.Error())
+}
+
 // DeleteByName deletes the device profile by name
 func (client *DeviceProfileClient) DeleteByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	var response common.BaseResponse
-	requestPath := path.Join(v2.ApiDeviceProfileRoute, v2.Name, url.QueryEscape(name))
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceProfileByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -90,8 +177,11 @@ func (client *DeviceProfileClient) DeleteByName(ctx context.Context, name string
 
 // DeviceProfileByName queries the device profile by name
 func (client *DeviceProfileClient) DeviceProfileByName(ctx context.Context, name string) (res responses.DeviceProfileResponse, edgexError errors.EdgeX) {
-	requestPath := path.Join(v2.ApiDeviceProfileRoute, v2.Name, url.QueryEscape(name))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceProfileByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -115,11 +205,14 @@ func (client *DeviceProfileClient) AllDeviceProfiles(ctx context.Context, labels
 
 // DeviceProfilesByModel queries the device profiles with offset, limit and model
 func (client *DeviceProfileClient) DeviceProfilesByModel(ctx context.Context, model string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
-	requestPath := path.Join(v2.ApiDeviceProfileRoute, v2.Model, url.QueryEscape(model))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceProfileByModelRoute).SetParam(v2.Model, model).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -128,11 +221,14 @@ func (client *DeviceProfileClient) DeviceProfilesByModel(ctx context.Context, mo
 
 // DeviceProfilesByManufacturer queries the device profiles with offset, limit and manufacturer
 func (client *DeviceProfileClient) DeviceProfilesByManufacturer(ctx context.Context, manufacturer string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
-	requestPath := path.Join(v2.ApiDeviceProfileRoute, v2.Manufacturer, url.QueryEscape(manufacturer))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceProfileByManufacturerRoute).SetParam(v2.Manufacturer, manufacturer).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -141,11 +237,14 @@ func (client *DeviceProfileClient) DeviceProfilesByManufacturer(ctx context.Cont
 
 // DeviceProfilesByManufacturerAndModel queries the device profiles with offset, limit, manufacturer and model
 func (client *DeviceProfileClient) DeviceProfilesByManufacturerAndModel(ctx context.Context, manufacturer string, model string, offset int, limit int) (res responses.MultiDeviceProfilesResponse, edgexError errors.EdgeX) {
-	requestPath := path.Join(v2.ApiDeviceProfileRoute, v2.Manufacturer, url.QueryEscape(manufacturer), v2.Model, url.QueryEscape(model))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceProfileByManufacturerAndModelRoute).SetParam(v2.Manufacturer, manufacturer).SetParam(v2.Model, model).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -159,8 +258,11 @@ func (client *DeviceProfileClient) DeviceResourceByProfileNameAndResourceName(ct
 	if exists {
 		return res, nil
 	}
-	requestPath := path.Join(v2.ApiDeviceResourceRoute, v2.Profile, url.QueryEscape(profileName), v2.Resource, url.QueryEscape(resourceName))
-	err := utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceResourceByProfileAndResourceRoute).SetParam(v2.ProfileName, profileName).SetParam(v2.ResourceName, resourceName).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
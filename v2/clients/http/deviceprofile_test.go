@@ -1,19 +1,25 @@
 package http
 
 import (
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
+	"io"
+	"io/ioutil"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
 	"os"
 	"path"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
 
 	edgexErrors "github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
@@ -219,6 +225,29 @@ func TestQueryDeviceProfilesByManufacturer(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func TestQueryDeviceProfilesByManufacturer_ReservedChars(t *testing.T) {
+	testManufacturer := "Dell Inc./EMEA+50%"
+	var decoded []string
+	ts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		// The path params are decoded from the escaped path segments as the router does
+		for _, segment := range strings.Split(r.URL.EscapedPath(), "/") {
+			value, err := url.PathUnescape(segment)
+			require.NoError(t, err)
+			decoded = append(decoded, value)
+		}
+		b, _ := json.Marshal(responses.MultiDeviceProfilesResponse{})
+		_, _ = w.Write(b)
+	}))
+	defer ts.Close()
+	client := NewDeviceProfileClient(ts.URL)
+
+	_, err := client.DeviceProfilesByManufacturer(context.Background(), testManufacturer, 1, 10)
+
+	require.NoError(t, err)
+	require.NotEmpty(t, decoded)
+	assert.Equal(t, testManufacturer, decoded[len(decoded)-1])
+}
+
 func TestQueryDeviceProfilesByManufacturerAndModel(t *testing.T) {
 	testManufacturer := "testManufacturer"
 	testModel := "testModel"
@@ -243,3 +272,126 @@ func TestDeviceResourceByProfileNameAndResourceName(t *testing.T) {
 	require.NoError(t, err)
 	assert.IsType(t, responses.DeviceResourceResponse{}, res)
 }
+
+func newYamlUploadTestServer(t *testing.T, method string, requestId string) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != method {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		if r.URL.EscapedPath() != v2.ApiDeviceProfileUploadFileRoute {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		// The multipart body is streamed, so its length is unknown in advance
+		assert.Equal(t, int64(-1), r.ContentLength)
+		file, header, err := r.FormFile("file")
+		if err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		defer file.Close()
+		content, _ := ioutil.ReadAll(file)
+		if len(content) == 0 || filepath.Ext(header.Filename) != ".yaml" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+
+		statusCode := http.StatusOK
+		if method == http.MethodPost {
+			statusCode = http.StatusCreated
+		}
+		w.WriteHeader(statusCode)
+		res, _ := json.Marshal(common.NewBaseWithIdResponse(requestId, header.Filename, statusCode, uuid.New().String()))
+		_, _ = w.Write(res)
+	}))
+}
+
+func TestAddDeviceProfileByYamlReader(t *testing.T) {
+	requestId := uuid.New().String()
+	ts := newYamlUploadTestServer(t, http.MethodPost, requestId)
+	defer ts.Close()
+	client := NewDeviceProfileClient(ts.URL)
+	_, b, _, _ := runtime.Caller(0)
+	content, err := ioutil.ReadFile(filepath.Dir(b) + "/data/sample-profile.yaml")
+	require.NoError(t, err)
+
+	res, edgexErr := client.AddByYamlReader(context.Background(), "profile.yaml", bytes.NewReader(content))
+	require.NoError(t, edgexErr)
+	assert.Equal(t, requestId, res.RequestId)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+	assert.Equal(t, "profile.yaml", res.Message)
+
+	res, edgexErr = client.AddByYamlBytes(context.Background(), "profile.yaml", content)
+	require.NoError(t, edgexErr)
+	assert.Equal(t, http.StatusCreated, res.StatusCode)
+
+	_, edgexErr = client.AddByYamlBytes(context.Background(), "profile.yaml", nil)
+	require.Error(t, edgexErr)
+	assert.Equal(t, http.StatusBadRequest, edgexErr.Code())
+}
+
+func TestUpdateDeviceProfileByYamlBytes(t *testing.T) {
+	requestId := uuid.New().String()
+	ts := newYamlUploadTestServer(t, http.MethodPut, requestId)
+	defer ts.Close()
+	client := NewDeviceProfileClient(ts.URL)
+
+	res, err := client.UpdateByYamlBytes(context.Background(), "profile.yaml", []byte("name: test"))
+	require.NoError(t, err)
+	assert.Equal(t, requestId, res.RequestId)
+	assert.Equal(t, http.StatusOK, res.StatusCode)
+}
+
+type closeRecorder struct {
+	io.Reader
+	closed bool
+}
+
+func (c *closeRecorder) Close() error {
+	c.closed = true
+	return nil
+}
+
+func TestAddDeviceProfilesByYamlFiles(t *testing.T) {
+	ts := newYamlUploadTestServer(t, http.MethodPost, uuid.New().String())
+	defer ts.Close()
+	client := NewDeviceProfileClient(ts.URL)
+
+	first := &closeRecorder{Reader: strings.NewReader("name: first")}
+	files := []clients.File{
+		{Name: "first.yaml", Content: first},
+		{Name: "invalid.txt", Content: strings.NewReader("name: invalid")},
+		{Name: "second.yaml", Content: strings.NewReader("name: second")},
+	}
+	res, err := client.AddByYamlFiles(context.Background(), files)
+	require.NoError(t, err)
+	require.Len(t, res, 3)
+	assert.Equal(t, http.StatusCreated, res[0].StatusCode)
+	assert.Equal(t, http.StatusBadRequest, res[1].StatusCode)
+	assert.Contains(t, res[1].Message, "invalid.txt")
+	assert.Equal(t, http.StatusCreated, res[2].StatusCode)
+	assert.True(t, first.closed)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	notUploaded := &closeRecorder{Reader: strings.NewReader("name: first")}
+	_, err = client.AddByYamlFiles(ctx, []clients.File{{Name: "first.yaml", Content: notUploaded}})
+	require.Error(t, err)
+	assert.True(t, notUploaded.closed, "the content should be closed even if it's not uploaded")
+}
+
+func TestUpdateDeviceProfilesByYamlFiles(t *testing.T) {
+	ts := newYamlUploadTestServer(t, http.MethodPut, uuid.New().String())
+	defer ts.Close()
+	client := NewDeviceProfileClient(ts.URL)
+
+	res, err := client.UpdateByYamlFiles(context.Background(), []clients.File{
+		{Name: "first.yaml", Content: strings.NewReader("name: first")},
+		{Name: "second.yaml", Content: strings.NewReader("")},
+	})
+	require.NoError(t, err)
+	require.Len(t, res, 2)
+	assert.Equal(t, http.StatusOK, res[0].StatusCode)
+	assert.Equal(t, http.StatusBadRequest, res[1].StatusCode)
+}
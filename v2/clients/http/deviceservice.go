@@ -3,7 +3,6 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 	"strings"
 
@@ -62,8 +61,11 @@ func (dsc DeviceServiceClient) AllDeviceServices(ctx context.Context, labels []s
 
 func (dsc DeviceServiceClient) DeviceServiceByName(ctx context.Context, name string) (
 	res responses.DeviceServiceResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiDeviceServiceRoute, v2.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, dsc.baseUrl, path, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceServiceByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, dsc.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -72,8 +74,11 @@ func (dsc DeviceServiceClient) DeviceServiceByName(ctx context.Context, name str
 
 func (dsc DeviceServiceClient) DeleteByName(ctx context.Context, name string) (
 	res common.BaseResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiDeviceServiceRoute, v2.Name, url.QueryEscape(name))
-	err = utils.DeleteRequest(ctx, &res, dsc.baseUrl, path)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceServiceByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, dsc.baseUrl, requestPath)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -7,7 +7,6 @@ package http
 
 import (
 	"context"
-	"path"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
@@ -48,8 +47,11 @@ func (client *deviceServiceCallbackClient) UpdateDeviceCallback(ctx context.Cont
 
 func (client *deviceServiceCallbackClient) DeleteDeviceCallback(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	var response common.BaseResponse
-	requestPath := path.Join(v2.ApiDeviceCallbackRoute, v2.Name, name)
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceCallbackNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -85,8 +87,11 @@ func (client *deviceServiceCallbackClient) UpdateProvisionWatcherCallback(ctx co
 
 func (client *deviceServiceCallbackClient) DeleteProvisionWatcherCallback(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
 	var response common.BaseResponse
-	requestPath := path.Join(v2.ApiWatcherCallbackRoute, v2.Name, name)
-	err := utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiWatcherCallbackNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &response, client.baseUrl, requestPath)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
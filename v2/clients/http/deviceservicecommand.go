@@ -9,7 +9,6 @@ import (
 	"context"
 	"encoding/json"
 	"net/url"
-	"path"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
@@ -31,7 +30,10 @@ func NewDeviceServiceCommandClient() interfaces.DeviceServiceCommandClient {
 
 // GetCommand sends HTTP request to execute the Get command
 func (client *deviceServiceCommandClient) GetCommand(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string) (*responses.EventResponse, errors.EdgeX) {
-	requestPath := path.Join(v2.ApiDeviceRoute, v2.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
+	requestPath, edgeXerr := v2.NewRouteBuilder(v2.ApiDeviceNameCommandNameRoute).SetParam(v2.Name, deviceName).SetParam(v2.Command, commandName).Build()
+	if edgeXerr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgeXerr)
+	}
 	params, err := url.ParseQuery(queryParams)
 	if err != nil {
 		return nil, errors.NewCommonEdgeXWrapper(err)
@@ -61,8 +63,11 @@ func (client *deviceServiceCommandClient) GetCommand(ctx context.Context, baseUr
 // SetCommand sends HTTP request to execute the Set command
 func (client *deviceServiceCommandClient) SetCommand(ctx context.Context, baseUrl string, deviceName string, commandName string, queryParams string, settings map[string]string) (common.BaseResponse, errors.EdgeX) {
 	var response common.BaseResponse
-	requestPath := path.Join(v2.ApiDeviceRoute, v2.Name, url.QueryEscape(deviceName), url.QueryEscape(commandName))
-	err := utils.PutRequest(ctx, &response, baseUrl+requestPath+"?"+queryParams, settings)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDeviceNameCommandNameRoute).SetParam(v2.Name, deviceName).SetParam(v2.Command, commandName).Build()
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.PutRequest(ctx, &response, baseUrl+requestPath+"?"+queryParams, settings)
 	if err != nil {
 		return response, errors.NewCommonEdgeXWrapper(err)
 	}
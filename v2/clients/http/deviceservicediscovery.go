@@ -0,0 +1,67 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+)
+
+type deviceServiceDiscoveryClient struct{}
+
+// NewDeviceServiceDiscoveryClient creates an instance of deviceServiceDiscoveryClient
+func NewDeviceServiceDiscoveryClient() interfaces.DeviceServiceDiscoveryClient {
+	return &deviceServiceDiscoveryClient{}
+}
+
+// TriggerDiscovery sends HTTP request to start the device discovery
+func (client *deviceServiceDiscoveryClient) TriggerDiscovery(ctx context.Context, baseUrl string, request requests.DiscoveryRequest) (responses.DiscoveryResponse, errors.EdgeX) {
+	var response responses.DiscoveryResponse
+	err := utils.PostRequestWithRawData(ctx, &response, baseUrl+v2.ApiDiscoveryRoute, request)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
+
+// DiscoveryById sends HTTP request to query the progress of the device discovery
+func (client *deviceServiceDiscoveryClient) DiscoveryById(ctx context.Context, baseUrl string, id string) (responses.DiscoveryResponse, errors.EdgeX) {
+	var response responses.DiscoveryResponse
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDiscoveryByIdRoute).SetParam(v2.Id, id).Build()
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &response, baseUrl, requestPath, nil)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
+
+// DiscoveredDevices sends HTTP request to query the devices found by the device discovery
+func (client *deviceServiceDiscoveryClient) DiscoveredDevices(ctx context.Context, baseUrl string, id string, offset int, limit int) (responses.MultiDiscoveredDevicesResponse, errors.EdgeX) {
+	var response responses.MultiDiscoveredDevicesResponse
+	requestPath, err := v2.NewRouteBuilder(v2.ApiDiscoveredDeviceRoute).SetParam(v2.Id, id).Build()
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	requestParams := url.Values{}
+	requestParams.Set(v2.Offset, strconv.Itoa(offset))
+	requestParams.Set(v2.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &response, baseUrl, requestPath, requestParams)
+	if err != nil {
+		return response, errors.NewCommonEdgeXWrapper(err)
+	}
+	return response, nil
+}
@@ -0,0 +1,70 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var testDiscoveryDTO = dtos.Discovery{
+	Id:          TestUUID,
+	ServiceName: "TestDeviceService",
+	State:       v2.DiscoveryStateRunning,
+	Progress:    50,
+	Started:     TestTimestamp,
+}
+
+func TestTriggerDiscovery(t *testing.T) {
+	requestId := uuid.New().String()
+	expectedResponse := responses.NewDiscoveryResponse(requestId, "", http.StatusAccepted, testDiscoveryDTO)
+	ts := newTestServer(http.MethodPost, v2.ApiDiscoveryRoute, expectedResponse)
+	defer ts.Close()
+
+	client := NewDeviceServiceDiscoveryClient()
+	res, err := client.TriggerDiscovery(context.Background(), ts.URL, requests.NewDiscoveryRequest(map[string]string{"subnet": "192.168.0.0/24"}))
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, res)
+}
+
+func TestDiscoveryById(t *testing.T) {
+	requestId := uuid.New().String()
+	expectedResponse := responses.NewDiscoveryResponse(requestId, "", http.StatusOK, testDiscoveryDTO)
+	ts := newTestServer(http.MethodGet, path.Join(v2.ApiDiscoveryRoute, v2.Id, TestUUID), expectedResponse)
+	defer ts.Close()
+
+	client := NewDeviceServiceDiscoveryClient()
+	res, err := client.DiscoveryById(context.Background(), ts.URL, TestUUID)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, res)
+}
+
+func TestDiscoveredDevices(t *testing.T) {
+	requestId := uuid.New().String()
+	devices := []dtos.DiscoveredDevice{{Name: TestDeviceName, Protocols: map[string]dtos.ProtocolProperties{"other": {"Address": "192.168.0.10"}}}}
+	expectedResponse := responses.NewMultiDiscoveredDevicesResponse(requestId, "", http.StatusOK, devices)
+	ts := newTestServer(http.MethodGet, path.Join(v2.ApiDiscoveryRoute, v2.Id, TestUUID, v2.Device), expectedResponse)
+	defer ts.Close()
+
+	client := NewDeviceServiceDiscoveryClient()
+	res, err := client.DiscoveredDevices(context.Background(), ts.URL, TestUUID, 0, 20)
+
+	require.NoError(t, err)
+	assert.Equal(t, expectedResponse, res)
+}
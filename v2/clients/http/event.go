@@ -8,7 +8,6 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
@@ -33,15 +32,17 @@ func NewEventClient(baseUrl string) interfaces.EventClient {
 
 func (ec *eventClient) Add(ctx context.Context, req requests.AddEventRequest) (
 	common.BaseWithIdResponse, errors.EdgeX) {
-	path := path.Join(v2.ApiEventRoute, url.QueryEscape(req.Event.ProfileName), url.QueryEscape(req.Event.DeviceName), url.QueryEscape(req.Event.SourceName))
 	var br common.BaseWithIdResponse
-
 	bytes, encoding, err := req.Encode()
 	if err != nil {
 		return br, errors.NewCommonEdgeXWrapper(err)
 	}
+	requestPath, err := v2.NewRouteBuilder(v2.ApiEventProfileNameDeviceNameSourceNameRoute).SetParam(v2.ProfileName, req.Event.ProfileName).SetParam(v2.DeviceName, req.Event.DeviceName).SetParam(v2.SourceName, req.Event.SourceName).Build()
+	if err != nil {
+		return br, errors.NewCommonEdgeXWrapper(err)
+	}
 
-	err = utils.PostRequest(ctx, &br, ec.baseUrl+path, bytes, encoding)
+	err = utils.PostRequest(ctx, &br, ec.baseUrl+requestPath, bytes, encoding)
 	if err != nil {
 		return br, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -70,9 +71,12 @@ func (ec *eventClient) EventCount(ctx context.Context) (common.CountResponse, er
 }
 
 func (ec *eventClient) EventCountByDeviceName(ctx context.Context, name string) (common.CountResponse, errors.EdgeX) {
-	requestPath := path.Join(v2.ApiEventCountRoute, v2.Device, v2.Name, url.QueryEscape(name))
 	res := common.CountResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiEventCountByDeviceNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -81,12 +85,15 @@ func (ec *eventClient) EventCountByDeviceName(ctx context.Context, name string)
 
 func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offset, limit int) (
 	responses.MultiEventsResponse, errors.EdgeX) {
-	requestPath := path.Join(v2.ApiEventRoute, v2.Device, v2.Name, url.QueryEscape(name))
+	res := responses.MultiEventsResponse{}
+	requestPath, err := v2.NewRouteBuilder(v2.ApiEventByDeviceNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
-	res := responses.MultiEventsResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -94,9 +101,12 @@ func (ec *eventClient) EventsByDeviceName(ctx context.Context, name string, offs
 }
 
 func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX) {
-	path := path.Join(v2.ApiEventRoute, v2.Device, v2.Name, url.QueryEscape(name))
 	res := common.BaseResponse{}
-	err := utils.DeleteRequest(ctx, &res, ec.baseUrl, path)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiEventByDeviceNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, ec.baseUrl, requestPath)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -105,12 +115,15 @@ func (ec *eventClient) DeleteByDeviceName(ctx context.Context, name string) (com
 
 func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end, offset, limit int) (
 	responses.MultiEventsResponse, errors.EdgeX) {
-	requestPath := path.Join(v2.ApiEventRoute, v2.Start, strconv.Itoa(start), v2.End, strconv.Itoa(end))
+	res := responses.MultiEventsResponse{}
+	requestPath, err := v2.NewRouteBuilder(v2.ApiEventByTimeRangeRoute).SetIntParam(v2.Start, start).SetIntParam(v2.End, end).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
-	res := responses.MultiEventsResponse{}
-	err := utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, ec.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -118,9 +131,12 @@ func (ec *eventClient) EventsByTimeRange(ctx context.Context, start, end, offset
 }
 
 func (ec *eventClient) DeleteByAge(ctx context.Context, age int) (common.BaseResponse, errors.EdgeX) {
-	path := path.Join(v2.ApiEventRoute, v2.Age, strconv.Itoa(age))
 	res := common.BaseResponse{}
-	err := utils.DeleteRequest(ctx, &res, ec.baseUrl, path)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiEventByAgeRoute).SetIntParam(v2.Age, age).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, ec.baseUrl, requestPath)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
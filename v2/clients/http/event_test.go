@@ -12,6 +12,7 @@ import (
 	"strconv"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
@@ -23,8 +24,8 @@ import (
 )
 
 func TestAddEvent(t *testing.T) {
-	event := dtos.Event{ProfileName: "profileName", DeviceName: "deviceName"}
-	apiRoute := path.Join(v2.ApiEventRoute, event.ProfileName, event.DeviceName)
+	event := dtos.Event{ProfileName: "profileName", DeviceName: "deviceName", SourceName: "sourceName"}
+	apiRoute := path.Join(v2.ApiEventRoute, event.ProfileName, event.DeviceName, event.SourceName)
 	ts := newTestServer(http.MethodPost, apiRoute, common.BaseWithIdResponse{})
 	defer ts.Close()
 
@@ -32,6 +33,11 @@ func TestAddEvent(t *testing.T) {
 	res, err := client.Add(context.Background(), requests.AddEventRequest{Event: event})
 	require.NoError(t, err)
 	assert.IsType(t, common.BaseWithIdResponse{}, res)
+
+	event.SourceName = ""
+	_, err = client.Add(context.Background(), requests.AddEventRequest{Event: event})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
 }
 
 func TestQueryAllEvents(t *testing.T) {
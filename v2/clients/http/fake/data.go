@@ -0,0 +1,271 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"time"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+
+	"github.com/google/uuid"
+)
+
+func (s *Server) registerEventRoutes() {
+	s.handle(http.MethodPost, v2.ApiEventProfileNameDeviceNameSourceNameRoute, s.addEvent)
+	s.handle(http.MethodGet, v2.ApiAllEventRoute, s.allEvents)
+	s.handle(http.MethodGet, v2.ApiEventCountRoute, s.eventCount)
+	s.handle(http.MethodGet, v2.ApiEventCountByDeviceNameRoute, s.eventCountByDeviceName)
+	s.handle(http.MethodGet, v2.ApiEventByDeviceNameRoute, s.eventsByDeviceName)
+	s.handle(http.MethodDelete, v2.ApiEventByDeviceNameRoute, s.deleteEventsByDeviceName)
+	s.handle(http.MethodGet, v2.ApiEventByTimeRangeRoute, s.eventsByTimeRange)
+	s.handle(http.MethodDelete, v2.ApiEventByAgeRoute, s.deleteEventsByAge)
+}
+
+func (s *Server) registerReadingRoutes() {
+	s.handle(http.MethodGet, v2.ApiAllReadingRoute, s.allReadings)
+	s.handle(http.MethodGet, v2.ApiReadingCountRoute, s.readingCount)
+	s.handle(http.MethodGet, v2.ApiReadingCountByDeviceNameRoute, s.readingCountByDeviceName)
+	s.handle(http.MethodGet, v2.ApiReadingByDeviceNameRoute, s.readingsByDeviceName)
+	s.handle(http.MethodGet, v2.ApiReadingByResourceNameRoute, s.readingsByResourceName)
+	s.handle(http.MethodGet, v2.ApiReadingByTimeRangeRoute, s.readingsByTimeRange)
+}
+
+// Event
+
+func (s *Server) addEvent(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	defer r.Body.Close()
+	data, err := ioutil.ReadAll(r.Body)
+	if err != nil {
+		writeError(w, "", errors.NewCommonEdgeX(errors.KindIOError, "failed to read the request body", err))
+		return
+	}
+	var req requests.AddEventRequest
+	if r.Header.Get(clients.ContentType) == clients.ContentTypeCBOR {
+		err = cbor.Unmarshal(data, &req)
+	} else {
+		err = req.UnmarshalJSON(data)
+	}
+	if err != nil {
+		writeError(w, "", errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the event", err))
+		return
+	}
+	event := req.Event
+	if event.ProfileName != vars[v2.ProfileName] || event.DeviceName != vars[v2.DeviceName] || event.SourceName != vars[v2.SourceName] {
+		writeError(w, req.RequestId, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			"the profileName, deviceName and sourceName of the event do not match the path variables", nil))
+		return
+	}
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	for _, e := range s.events {
+		if e.Id == event.Id {
+			writeError(w, req.RequestId, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("event id %s exists", event.Id), nil))
+			return
+		}
+	}
+	for i := range event.Readings {
+		if event.Readings[i].Id == "" {
+			event.Readings[i].Id = uuid.NewString()
+		}
+	}
+	s.events = append(s.events, event)
+	// Keep the newest events first, as core-data does
+	sort.SliceStable(s.events, func(i, j int) bool {
+		return s.events[i].Origin > s.events[j].Origin
+	})
+	writeJSON(w, http.StatusCreated, common.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, event.Id))
+}
+
+func (s *Server) allEvents(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	s.listEvents(w, r, func(dtos.Event) bool { return true })
+}
+
+func (s *Server) eventsByDeviceName(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	s.listEvents(w, r, func(e dtos.Event) bool {
+		return e.DeviceName == vars[v2.Name]
+	})
+}
+
+func (s *Server) eventsByTimeRange(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	start, end, err := parseTimeRange(vars)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.listEvents(w, r, func(e dtos.Event) bool {
+		return e.Origin >= start && e.Origin <= end
+	})
+}
+
+func (s *Server) listEvents(w http.ResponseWriter, r *http.Request, filter func(dtos.Event) bool) {
+	offset, limit, err := parseOffsetAndLimit(r)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	events := s.filterEvents(filter)
+	start, end, err := pageRange(len(events), offset, limit)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.NewMultiEventsResponse("", "", http.StatusOK, events[start:end]))
+}
+
+func (s *Server) eventCount(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	writeJSON(w, http.StatusOK, common.NewCountResponse("", "", http.StatusOK, uint32(len(s.events))))
+}
+
+func (s *Server) eventCountByDeviceName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	events := s.filterEvents(func(e dtos.Event) bool {
+		return e.DeviceName == vars[v2.Name]
+	})
+	writeJSON(w, http.StatusOK, common.NewCountResponse("", "", http.StatusOK, uint32(len(events))))
+}
+
+func (s *Server) deleteEventsByDeviceName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.events = s.filterEvents(func(e dtos.Event) bool {
+		return e.DeviceName != vars[v2.Name]
+	})
+	writeJSON(w, http.StatusAccepted, common.NewBaseResponse("", "", http.StatusAccepted))
+}
+
+func (s *Server) deleteEventsByAge(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	age, err := parseIntVar(vars, v2.Age)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	expireTimestamp := time.Now().UnixNano() - age
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.events = s.filterEvents(func(e dtos.Event) bool {
+		return e.Origin >= expireTimestamp
+	})
+	writeJSON(w, http.StatusAccepted, common.NewBaseResponse("", "", http.StatusAccepted))
+}
+
+// filterEvents returns the events, newest first, which satisfy the filter
+func (s *Server) filterEvents(filter func(dtos.Event) bool) []dtos.Event {
+	events := make([]dtos.Event, 0, len(s.events))
+	for _, e := range s.events {
+		if filter(e) {
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+// Reading
+
+func (s *Server) allReadings(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	s.listReadings(w, r, func(dtos.BaseReading) bool { return true })
+}
+
+func (s *Server) readingsByDeviceName(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	s.listReadings(w, r, func(reading dtos.BaseReading) bool {
+		return reading.DeviceName == vars[v2.Name]
+	})
+}
+
+func (s *Server) readingsByResourceName(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	s.listReadings(w, r, func(reading dtos.BaseReading) bool {
+		return reading.ResourceName == vars[v2.ResourceName]
+	})
+}
+
+func (s *Server) readingsByTimeRange(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	start, end, err := parseTimeRange(vars)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.listReadings(w, r, func(reading dtos.BaseReading) bool {
+		return reading.Origin >= start && reading.Origin <= end
+	})
+}
+
+func (s *Server) listReadings(w http.ResponseWriter, r *http.Request, filter func(dtos.BaseReading) bool) {
+	offset, limit, err := parseOffsetAndLimit(r)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	readings := s.filterReadings(filter)
+	start, end, err := pageRange(len(readings), offset, limit)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.NewMultiReadingsResponse("", "", http.StatusOK, readings[start:end]))
+}
+
+func (s *Server) readingCount(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	readings := s.filterReadings(func(dtos.BaseReading) bool { return true })
+	writeJSON(w, http.StatusOK, common.NewCountResponse("", "", http.StatusOK, uint32(len(readings))))
+}
+
+func (s *Server) readingCountByDeviceName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	readings := s.filterReadings(func(reading dtos.BaseReading) bool {
+		return reading.DeviceName == vars[v2.Name]
+	})
+	writeJSON(w, http.StatusOK, common.NewCountResponse("", "", http.StatusOK, uint32(len(readings))))
+}
+
+// filterReadings returns the readings of the stored events which satisfy the filter
+func (s *Server) filterReadings(filter func(dtos.BaseReading) bool) []dtos.BaseReading {
+	var readings []dtos.BaseReading
+	for _, e := range s.events {
+		for _, reading := range e.Readings {
+			if filter(reading) {
+				readings = append(readings, reading)
+			}
+		}
+	}
+	return readings
+}
+
+func parseTimeRange(vars map[string]string) (int64, int64, errors.EdgeX) {
+	start, err := parseIntVar(vars, v2.Start)
+	if err != nil {
+		return 0, 0, err
+	}
+	end, err := parseIntVar(vars, v2.End)
+	if err != nil {
+		return 0, 0, err
+	}
+	if end < start {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("end %d is earlier than start %d", end, start), nil)
+	}
+	return start, end, nil
+}
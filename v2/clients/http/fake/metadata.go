@@ -0,0 +1,806 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net/http"
+
+	"github.com/google/uuid"
+	"gopkg.in/yaml.v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+)
+
+func (s *Server) registerDeviceServiceRoutes() {
+	s.handle(http.MethodPost, v2.ApiDeviceServiceRoute, s.addDeviceServices)
+	s.handle(http.MethodPatch, v2.ApiDeviceServiceRoute, s.updateDeviceServices)
+	s.handle(http.MethodGet, v2.ApiAllDeviceServiceRoute, s.allDeviceServices)
+	s.handle(http.MethodGet, v2.ApiDeviceServiceByNameRoute, s.deviceServiceByName)
+	s.handle(http.MethodDelete, v2.ApiDeviceServiceByNameRoute, s.deleteDeviceServiceByName)
+}
+
+func (s *Server) registerDeviceProfileRoutes() {
+	s.handle(http.MethodPost, v2.ApiDeviceProfileRoute, s.addDeviceProfiles)
+	s.handle(http.MethodPut, v2.ApiDeviceProfileRoute, s.updateDeviceProfiles)
+	s.handle(http.MethodPost, v2.ApiDeviceProfileUploadFileRoute, s.addDeviceProfileByYaml)
+	s.handle(http.MethodPut, v2.ApiDeviceProfileUploadFileRoute, s.updateDeviceProfileByYaml)
+	s.handle(http.MethodGet, v2.ApiAllDeviceProfileRoute, s.allDeviceProfiles)
+	s.handle(http.MethodGet, v2.ApiDeviceProfileByNameRoute, s.deviceProfileByName)
+	s.handle(http.MethodDelete, v2.ApiDeviceProfileByNameRoute, s.deleteDeviceProfileByName)
+	s.handle(http.MethodGet, v2.ApiDeviceProfileByManufacturerAndModelRoute, s.deviceProfilesByManufacturerAndModel)
+	s.handle(http.MethodGet, v2.ApiDeviceProfileByManufacturerRoute, s.deviceProfilesByManufacturerAndModel)
+	s.handle(http.MethodGet, v2.ApiDeviceProfileByModelRoute, s.deviceProfilesByManufacturerAndModel)
+	s.handle(http.MethodGet, v2.ApiDeviceResourceByProfileAndResourceRoute, s.deviceResourceByProfileAndResource)
+}
+
+func (s *Server) registerDeviceRoutes() {
+	s.handle(http.MethodPost, v2.ApiDeviceRoute, s.addDevices)
+	s.handle(http.MethodPatch, v2.ApiDeviceRoute, s.updateDevices)
+	s.handle(http.MethodGet, v2.ApiAllDeviceRoute, s.allDevices)
+	s.handle(http.MethodGet, v2.ApiDeviceNameExistsRoute, s.deviceNameExists)
+	s.handle(http.MethodGet, v2.ApiDeviceByNameRoute, s.deviceByName)
+	s.handle(http.MethodDelete, v2.ApiDeviceByNameRoute, s.deleteDeviceByName)
+	s.handle(http.MethodGet, v2.ApiDeviceByProfileNameRoute, s.devicesByProfileName)
+	s.handle(http.MethodGet, v2.ApiDeviceByServiceNameRoute, s.devicesByServiceName)
+}
+
+func (s *Server) registerProvisionWatcherRoutes() {
+	s.handle(http.MethodPost, v2.ApiProvisionWatcherRoute, s.addProvisionWatchers)
+	s.handle(http.MethodPatch, v2.ApiProvisionWatcherRoute, s.updateProvisionWatchers)
+	s.handle(http.MethodGet, v2.ApiAllProvisionWatcherRoute, s.allProvisionWatchers)
+	s.handle(http.MethodGet, v2.ApiProvisionWatcherByNameRoute, s.provisionWatcherByName)
+	s.handle(http.MethodDelete, v2.ApiProvisionWatcherByNameRoute, s.deleteProvisionWatcherByName)
+	s.handle(http.MethodGet, v2.ApiProvisionWatcherByProfileNameRoute, s.provisionWatchersByProfileName)
+	s.handle(http.MethodGet, v2.ApiProvisionWatcherByServiceNameRoute, s.provisionWatchersByServiceName)
+}
+
+// Device service
+
+func (s *Server) addDeviceServices(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var reqs []requests.AddDeviceServiceRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		ds := req.Service
+		if _, exists := s.services[ds.Name]; exists {
+			res[i] = withIdErrorResponse(req.RequestId, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device service name %s exists", ds.Name), nil))
+			continue
+		}
+		initEntity(&ds.Id, &ds.DBTimestamp)
+		s.services[ds.Name] = ds
+		res[i] = common.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, ds.Id)
+	}
+	writeJSON(w, http.StatusMultiStatus, res)
+}
+
+func (s *Server) updateDeviceServices(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var reqs []requests.UpdateDeviceServiceRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		patch := req.Service
+		ds, err := s.findDeviceService(patch.Id, patch.Name)
+		if err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		oldName := ds.Name
+		if patch.Name != nil && *patch.Name != oldName {
+			if _, exists := s.services[*patch.Name]; exists {
+				res[i] = errorResponse(req.RequestId, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device service name %s exists", *patch.Name), nil))
+				continue
+			}
+			if s.serviceInUse(oldName) {
+				res[i] = errorResponse(req.RequestId, errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("device service %s is in use", oldName), nil))
+				continue
+			}
+		}
+		m := dtos.ToDeviceServiceModel(ds)
+		if err = requests.ApplyDeviceServiceUpdate(&m, patch, req.FieldMask...); err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		updated := dtos.FromDeviceServiceModelToDTO(m)
+		if patch.Name != nil {
+			updated.Name = *patch.Name
+		}
+		updated.Created, updated.Modified = ds.Created, makeTimestamp()
+		delete(s.services, oldName)
+		s.services[updated.Name] = updated
+		res[i] = common.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	}
+	writeJSON(w, http.StatusMultiStatus, res)
+}
+
+func (s *Server) allDeviceServices(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	offset, limit, err := parseOffsetAndLimit(r)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	labels := parseLabels(r)
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	created := make(map[string]int64)
+	for name, ds := range s.services {
+		if hasAnyLabel(ds.Labels, labels) {
+			created[name] = ds.Created
+		}
+	}
+	names := sortedNames(created)
+	start, end, err := pageRange(len(names), offset, limit)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	services := make([]dtos.DeviceService, 0, end-start)
+	for _, name := range names[start:end] {
+		services = append(services, s.services[name])
+	}
+	writeJSON(w, http.StatusOK, responses.NewMultiDeviceServicesResponse("", "", http.StatusOK, services))
+}
+
+func (s *Server) deviceServiceByName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	name := vars[v2.Name]
+	ds, err := s.findDeviceService(nil, &name)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.NewDeviceServiceResponse("", "", http.StatusOK, ds))
+}
+
+func (s *Server) deleteDeviceServiceByName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	name := vars[v2.Name]
+	if _, err := s.findDeviceService(nil, &name); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	if s.serviceInUse(name) {
+		writeError(w, "", errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("fail to delete the device service %s which is in use", name), nil))
+		return
+	}
+	delete(s.services, name)
+	writeJSON(w, http.StatusOK, common.NewBaseResponse("", "", http.StatusOK))
+}
+
+func (s *Server) findDeviceService(id *string, name *string) (dtos.DeviceService, errors.EdgeX) {
+	if name != nil {
+		if ds, ok := s.services[*name]; ok && (id == nil || ds.Id == *id) {
+			return ds, nil
+		}
+	}
+	if id != nil {
+		for _, ds := range s.services {
+			if ds.Id == *id {
+				return ds, nil
+			}
+		}
+	}
+	return dtos.DeviceService{}, notFoundError("device service", id, name)
+}
+
+func (s *Server) serviceInUse(name string) bool {
+	for _, d := range s.devices {
+		if d.ServiceName == name {
+			return true
+		}
+	}
+	for _, pw := range s.watchers {
+		if pw.ServiceName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Device profile
+
+func (s *Server) addDeviceProfiles(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var reqs []requests.DeviceProfileRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		id, err := s.addDeviceProfile(req.Profile)
+		if err != nil {
+			res[i] = withIdErrorResponse(req.RequestId, err)
+			continue
+		}
+		res[i] = common.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, id)
+	}
+	writeJSON(w, http.StatusMultiStatus, res)
+}
+
+func (s *Server) updateDeviceProfiles(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var reqs []requests.DeviceProfileRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		if err := s.updateDeviceProfile(req.Profile); err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		res[i] = common.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	}
+	writeJSON(w, http.StatusMultiStatus, res)
+}
+
+func (s *Server) addDeviceProfileByYaml(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	profile, err := readDeviceProfileYaml(r)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	id, err := s.addDeviceProfile(profile)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusCreated, common.NewBaseWithIdResponse("", "", http.StatusCreated, id))
+}
+
+func (s *Server) updateDeviceProfileByYaml(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	profile, err := readDeviceProfileYaml(r)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	if err = s.updateDeviceProfile(profile); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, common.NewBaseResponse("", "", http.StatusOK))
+}
+
+func readDeviceProfileYaml(r *http.Request) (dtos.DeviceProfile, errors.EdgeX) {
+	var profile dtos.DeviceProfile
+	file, _, err := r.FormFile("file")
+	if err != nil {
+		return profile, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to read the file from the form data", err)
+	}
+	defer file.Close()
+	data, err := ioutil.ReadAll(file)
+	if err != nil {
+		return profile, errors.NewCommonEdgeX(errors.KindIOError, "failed to read the file", err)
+	}
+	if len(data) == 0 {
+		return profile, errors.NewCommonEdgeX(errors.KindContractInvalid, "file is empty", nil)
+	}
+	if err = yaml.Unmarshal(data, &profile); err != nil {
+		return profile, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal the device profile YAML", err)
+	}
+	return profile, nil
+}
+
+func (s *Server) addDeviceProfile(profile dtos.DeviceProfile) (string, errors.EdgeX) {
+	if _, exists := s.profiles[profile.Name]; exists {
+		return "", errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile name %s exists", profile.Name), nil)
+	}
+	initEntity(&profile.Id, &profile.DBTimestamp)
+	s.profiles[profile.Name] = profile
+	return profile.Id, nil
+}
+
+func (s *Server) updateDeviceProfile(profile dtos.DeviceProfile) errors.EdgeX {
+	existing, err := s.findDeviceProfile(&profile.Name)
+	if err != nil {
+		return err
+	}
+	profile.Id = existing.Id
+	profile.Created, profile.Modified = existing.Created, makeTimestamp()
+	s.profiles[profile.Name] = profile
+	return nil
+}
+
+func (s *Server) allDeviceProfiles(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	labels := parseLabels(r)
+	s.listDeviceProfiles(w, r, func(p dtos.DeviceProfile) bool {
+		return hasAnyLabel(p.Labels, labels)
+	})
+}
+
+func (s *Server) deviceProfilesByManufacturerAndModel(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	manufacturer, filterManufacturer := vars[v2.Manufacturer]
+	model, filterModel := vars[v2.Model]
+	s.listDeviceProfiles(w, r, func(p dtos.DeviceProfile) bool {
+		return (!filterManufacturer || p.Manufacturer == manufacturer) && (!filterModel || p.Model == model)
+	})
+}
+
+func (s *Server) listDeviceProfiles(w http.ResponseWriter, r *http.Request, filter func(dtos.DeviceProfile) bool) {
+	offset, limit, err := parseOffsetAndLimit(r)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	created := make(map[string]int64)
+	for name, p := range s.profiles {
+		if filter(p) {
+			created[name] = p.Created
+		}
+	}
+	names := sortedNames(created)
+	start, end, err := pageRange(len(names), offset, limit)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	profiles := make([]dtos.DeviceProfile, 0, end-start)
+	for _, name := range names[start:end] {
+		profiles = append(profiles, s.profiles[name])
+	}
+	writeJSON(w, http.StatusOK, responses.NewMultiDeviceProfilesResponse("", "", http.StatusOK, profiles))
+}
+
+func (s *Server) deviceProfileByName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	name := vars[v2.Name]
+	profile, err := s.findDeviceProfile(&name)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.NewDeviceProfileResponse("", "", http.StatusOK, profile))
+}
+
+func (s *Server) deleteDeviceProfileByName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	name := vars[v2.Name]
+	if _, err := s.findDeviceProfile(&name); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	if s.profileInUse(name) {
+		writeError(w, "", errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("fail to delete the device profile %s which is in use", name), nil))
+		return
+	}
+	delete(s.profiles, name)
+	writeJSON(w, http.StatusOK, common.NewBaseResponse("", "", http.StatusOK))
+}
+
+func (s *Server) deviceResourceByProfileAndResource(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	profileName := vars[v2.ProfileName]
+	profile, err := s.findDeviceProfile(&profileName)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	for _, resource := range profile.DeviceResources {
+		if resource.Name == vars[v2.ResourceName] {
+			writeJSON(w, http.StatusOK, responses.NewDeviceResourceResponse("", "", http.StatusOK, resource))
+			return
+		}
+	}
+	writeError(w, "", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+		fmt.Sprintf("device resource %s not found in the device profile %s", vars[v2.ResourceName], profileName), nil))
+}
+
+func (s *Server) findDeviceProfile(name *string) (dtos.DeviceProfile, errors.EdgeX) {
+	if p, ok := s.profiles[*name]; ok {
+		return p, nil
+	}
+	return dtos.DeviceProfile{}, notFoundError("device profile", nil, name)
+}
+
+func (s *Server) profileInUse(name string) bool {
+	for _, d := range s.devices {
+		if d.ProfileName == name {
+			return true
+		}
+	}
+	for _, pw := range s.watchers {
+		if pw.ProfileName == name {
+			return true
+		}
+	}
+	return false
+}
+
+// Device
+
+func (s *Server) addDevices(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var reqs []requests.AddDeviceRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		d := req.Device
+		if _, exists := s.devices[d.Name]; exists {
+			res[i] = withIdErrorResponse(req.RequestId, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s exists", d.Name), nil))
+			continue
+		}
+		if err := s.checkReferences(d.ServiceName, d.ProfileName); err != nil {
+			res[i] = withIdErrorResponse(req.RequestId, err)
+			continue
+		}
+		initEntity(&d.Id, &d.DBTimestamp)
+		s.devices[d.Name] = d
+		res[i] = common.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, d.Id)
+	}
+	writeJSON(w, http.StatusMultiStatus, res)
+}
+
+func (s *Server) updateDevices(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var reqs []requests.UpdateDeviceRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		patch := req.Device
+		d, err := s.findDevice(patch.Id, patch.Name)
+		if err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		oldName := d.Name
+		if patch.Name != nil && *patch.Name != oldName {
+			if _, exists := s.devices[*patch.Name]; exists {
+				res[i] = errorResponse(req.RequestId, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device name %s exists", *patch.Name), nil))
+				continue
+			}
+		}
+		m := dtos.ToDeviceModel(d)
+		if err = requests.ApplyDeviceUpdate(&m, patch, req.FieldMask...); err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		updated := dtos.FromDeviceModelToDTO(m)
+		if patch.Name != nil {
+			updated.Name = *patch.Name
+		}
+		if err = s.checkReferences(updated.ServiceName, updated.ProfileName); err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		updated.Created, updated.Modified = d.Created, makeTimestamp()
+		delete(s.devices, oldName)
+		s.devices[updated.Name] = updated
+		res[i] = common.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	}
+	writeJSON(w, http.StatusMultiStatus, res)
+}
+
+func (s *Server) allDevices(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	labels := parseLabels(r)
+	s.listDevices(w, r, func(d dtos.Device) bool {
+		return hasAnyLabel(d.Labels, labels)
+	})
+}
+
+func (s *Server) devicesByProfileName(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	s.listDevices(w, r, func(d dtos.Device) bool {
+		return d.ProfileName == vars[v2.Name]
+	})
+}
+
+func (s *Server) devicesByServiceName(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	s.listDevices(w, r, func(d dtos.Device) bool {
+		return d.ServiceName == vars[v2.Name]
+	})
+}
+
+func (s *Server) listDevices(w http.ResponseWriter, r *http.Request, filter func(dtos.Device) bool) {
+	offset, limit, err := parseOffsetAndLimit(r)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	created := make(map[string]int64)
+	for name, d := range s.devices {
+		if filter(d) {
+			created[name] = d.Created
+		}
+	}
+	names := sortedNames(created)
+	start, end, err := pageRange(len(names), offset, limit)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	devices := make([]dtos.Device, 0, end-start)
+	for _, name := range names[start:end] {
+		devices = append(devices, s.devices[name])
+	}
+	writeJSON(w, http.StatusOK, responses.NewMultiDevicesResponse("", "", http.StatusOK, devices))
+}
+
+func (s *Server) deviceNameExists(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	name := vars[v2.Name]
+	if _, err := s.findDevice(nil, &name); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, common.NewBaseResponse("", "", http.StatusOK))
+}
+
+func (s *Server) deviceByName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	name := vars[v2.Name]
+	d, err := s.findDevice(nil, &name)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.NewDeviceResponse("", "", http.StatusOK, d))
+}
+
+func (s *Server) deleteDeviceByName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	name := vars[v2.Name]
+	if _, err := s.findDevice(nil, &name); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	delete(s.devices, name)
+	writeJSON(w, http.StatusOK, common.NewBaseResponse("", "", http.StatusOK))
+}
+
+func (s *Server) findDevice(id *string, name *string) (dtos.Device, errors.EdgeX) {
+	if name != nil {
+		if d, ok := s.devices[*name]; ok && (id == nil || d.Id == *id) {
+			return d, nil
+		}
+	}
+	if id != nil {
+		for _, d := range s.devices {
+			if d.Id == *id {
+				return d, nil
+			}
+		}
+	}
+	return dtos.Device{}, notFoundError("device", id, name)
+}
+
+// checkReferences checks whether the referenced device service and device profile exist
+func (s *Server) checkReferences(serviceName string, profileName string) errors.EdgeX {
+	if _, ok := s.services[serviceName]; !ok {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device service %s does not exist", serviceName), nil)
+	}
+	if _, ok := s.profiles[profileName]; !ok {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile %s does not exist", profileName), nil)
+	}
+	return nil
+}
+
+// Provision watcher
+
+func (s *Server) addProvisionWatchers(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var reqs []requests.AddProvisionWatcherRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		pw := req.ProvisionWatcher
+		if _, exists := s.watchers[pw.Name]; exists {
+			res[i] = withIdErrorResponse(req.RequestId, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("provision watcher name %s exists", pw.Name), nil))
+			continue
+		}
+		if err := s.checkReferences(pw.ServiceName, pw.ProfileName); err != nil {
+			res[i] = withIdErrorResponse(req.RequestId, err)
+			continue
+		}
+		initEntity(&pw.Id, &pw.DBTimestamp)
+		s.watchers[pw.Name] = pw
+		res[i] = common.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, pw.Id)
+	}
+	writeJSON(w, http.StatusMultiStatus, res)
+}
+
+func (s *Server) updateProvisionWatchers(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	var reqs []requests.UpdateProvisionWatcherRequest
+	if err := decodeJSON(r, &reqs); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		patch := req.ProvisionWatcher
+		pw, err := s.findProvisionWatcher(patch.Id, patch.Name)
+		if err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		oldName := pw.Name
+		if patch.Name != nil && *patch.Name != oldName {
+			if _, exists := s.watchers[*patch.Name]; exists {
+				res[i] = errorResponse(req.RequestId, errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("provision watcher name %s exists", *patch.Name), nil))
+				continue
+			}
+		}
+		m := dtos.ToProvisionWatcherModel(pw)
+		if err = requests.ApplyProvisionWatcherUpdate(&m, patch, req.FieldMask...); err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		updated := dtos.FromProvisionWatcherModelToDTO(m)
+		if patch.Name != nil {
+			updated.Name = *patch.Name
+		}
+		if err = s.checkReferences(updated.ServiceName, updated.ProfileName); err != nil {
+			res[i] = errorResponse(req.RequestId, err)
+			continue
+		}
+		updated.Created, updated.Modified = pw.Created, makeTimestamp()
+		delete(s.watchers, oldName)
+		s.watchers[updated.Name] = updated
+		res[i] = common.NewBaseResponse(req.RequestId, "", http.StatusOK)
+	}
+	writeJSON(w, http.StatusMultiStatus, res)
+}
+
+func (s *Server) allProvisionWatchers(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+	labels := parseLabels(r)
+	s.listProvisionWatchers(w, r, func(pw dtos.ProvisionWatcher) bool {
+		return hasAnyLabel(pw.Labels, labels)
+	})
+}
+
+func (s *Server) provisionWatchersByProfileName(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	s.listProvisionWatchers(w, r, func(pw dtos.ProvisionWatcher) bool {
+		return pw.ProfileName == vars[v2.Name]
+	})
+}
+
+func (s *Server) provisionWatchersByServiceName(w http.ResponseWriter, r *http.Request, vars map[string]string) {
+	s.listProvisionWatchers(w, r, func(pw dtos.ProvisionWatcher) bool {
+		return pw.ServiceName == vars[v2.Name]
+	})
+}
+
+func (s *Server) listProvisionWatchers(w http.ResponseWriter, r *http.Request, filter func(dtos.ProvisionWatcher) bool) {
+	offset, limit, err := parseOffsetAndLimit(r)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	created := make(map[string]int64)
+	for name, pw := range s.watchers {
+		if filter(pw) {
+			created[name] = pw.Created
+		}
+	}
+	names := sortedNames(created)
+	start, end, err := pageRange(len(names), offset, limit)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	watchers := make([]dtos.ProvisionWatcher, 0, end-start)
+	for _, name := range names[start:end] {
+		watchers = append(watchers, s.watchers[name])
+	}
+	writeJSON(w, http.StatusOK, responses.NewMultiProvisionWatchersResponse("", "", http.StatusOK, watchers))
+}
+
+func (s *Server) provisionWatcherByName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+	name := vars[v2.Name]
+	pw, err := s.findProvisionWatcher(nil, &name)
+	if err != nil {
+		writeError(w, "", err)
+		return
+	}
+	writeJSON(w, http.StatusOK, responses.NewProvisionWatcherResponse("", "", http.StatusOK, pw))
+}
+
+func (s *Server) deleteProvisionWatcherByName(w http.ResponseWriter, _ *http.Request, vars map[string]string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	name := vars[v2.Name]
+	if _, err := s.findProvisionWatcher(nil, &name); err != nil {
+		writeError(w, "", err)
+		return
+	}
+	delete(s.watchers, name)
+	writeJSON(w, http.StatusOK, common.NewBaseResponse("", "", http.StatusOK))
+}
+
+func (s *Server) findProvisionWatcher(id *string, name *string) (dtos.ProvisionWatcher, errors.EdgeX) {
+	if name != nil {
+		if pw, ok := s.watchers[*name]; ok && (id == nil || pw.Id == *id) {
+			return pw, nil
+		}
+	}
+	if id != nil {
+		for _, pw := range s.watchers {
+			if pw.Id == *id {
+				return pw, nil
+			}
+		}
+	}
+	return dtos.ProvisionWatcher{}, notFoundError("provision watcher", id, name)
+}
+
+// initEntity assigns the id if not specified, and sets the created and modified timestamps of a new entity
+func initEntity(id *string, ts *dtos.DBTimestamp) {
+	if *id == "" {
+		*id = uuid.NewString()
+	}
+	ts.Created = makeTimestamp()
+	ts.Modified = ts.Created
+}
+
+func notFoundError(entity string, id *string, name *string) errors.EdgeX {
+	if name != nil {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s %s does not exist", entity, *name), nil)
+	}
+	if id != nil {
+		return errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("%s with id %s does not exist", entity, *id), nil)
+	}
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("either the id or the name of %s is required", entity), nil)
+}
+
+func errorResponse(requestId string, err errors.EdgeX) common.BaseResponse {
+	return common.NewBaseResponse(requestId, err.Message(), err.Code())
+}
+
+func withIdErrorResponse(requestId string, err errors.EdgeX) common.BaseWithIdResponse {
+	return common.BaseWithIdResponse{BaseResponse: errorResponse(requestId, err)}
+}
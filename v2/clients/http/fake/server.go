@@ -0,0 +1,254 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package fake provides an in-process fake of the EdgeX core-metadata and core-data services, which serves the v2
+// routes for devices, device profiles, device services, provision watchers, events and readings from an in-memory
+// store, so that the clients in the clients/http package can be tested end-to-end without the real services.
+package fake
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+)
+
+// Version is returned by the version route of the fake server
+const Version = "0.0.0-fake"
+
+type handlerFunc func(w http.ResponseWriter, r *http.Request, vars map[string]string)
+
+type route struct {
+	method   string
+	segments []string
+	handler  handlerFunc
+}
+
+// Server is an in-memory fake of the EdgeX core-metadata and core-data services. The URL of the embedded
+// httptest.Server is used as the baseUrl of the clients, e.g. http.NewDeviceClient(server.URL).
+type Server struct {
+	*httptest.Server
+
+	mutex    sync.RWMutex
+	routes   []route
+	devices  map[string]dtos.Device
+	profiles map[string]dtos.DeviceProfile
+	services map[string]dtos.DeviceService
+	watchers map[string]dtos.ProvisionWatcher
+	events   []dtos.Event
+}
+
+// NewServer creates and starts the fake Server, which should be closed by the caller when finished
+func NewServer() *Server {
+	s := &Server{
+		devices:  make(map[string]dtos.Device),
+		profiles: make(map[string]dtos.DeviceProfile),
+		services: make(map[string]dtos.DeviceService),
+		watchers: make(map[string]dtos.ProvisionWatcher),
+	}
+	s.registerRoutes()
+	s.Server = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// Reset removes all the entities from the in-memory store
+func (s *Server) Reset() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	s.devices = make(map[string]dtos.Device)
+	s.profiles = make(map[string]dtos.DeviceProfile)
+	s.services = make(map[string]dtos.DeviceService)
+	s.watchers = make(map[string]dtos.ProvisionWatcher)
+	s.events = nil
+}
+
+func (s *Server) registerRoutes() {
+	s.handle(http.MethodGet, v2.ApiPingRoute, s.ping)
+	s.handle(http.MethodGet, v2.ApiVersionRoute, s.version)
+
+	s.registerDeviceServiceRoutes()
+	s.registerDeviceProfileRoutes()
+	s.registerDeviceRoutes()
+	s.registerProvisionWatcherRoutes()
+	s.registerEventRoutes()
+	s.registerReadingRoutes()
+}
+
+func (s *Server) handle(method string, template string, handler handlerFunc) {
+	s.routes = append(s.routes, route{
+		method:   method,
+		segments: strings.Split(strings.Trim(template, "/"), "/"),
+		handler:  handler,
+	})
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	// The escaped path is split, so an escaped slash in a path variable doesn't split the variable
+	segments := strings.Split(strings.Trim(r.URL.EscapedPath(), "/"), "/")
+	pathMatched := false
+	for _, rt := range s.routes {
+		vars, ok := rt.match(segments)
+		if !ok {
+			continue
+		}
+		pathMatched = true
+		if rt.method != r.Method {
+			continue
+		}
+		rt.handler(w, r, vars)
+		return
+	}
+	if pathMatched {
+		writeError(w, "", errors.NewCommonEdgeX(errors.KindNotAllowed, fmt.Sprintf("method %s is not allowed", r.Method), nil))
+		return
+	}
+	writeError(w, "", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("route %s not found", r.URL.Path), nil))
+}
+
+// match checks the request path segments against the route template, and returns the unescaped path variables
+func (rt route) match(segments []string) (map[string]string, bool) {
+	if len(segments) != len(rt.segments) {
+		return nil, false
+	}
+	vars := make(map[string]string)
+	for i, seg := range rt.segments {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			// The clients escape the path variables with v2.EscapePathParam, which escapes the space as %20, so the
+			// path variables are decoded as path segments, where + is a literal plus sign
+			value, err := url.PathUnescape(segments[i])
+			if err != nil {
+				return nil, false
+			}
+			vars[seg[1:len(seg)-1]] = value
+			continue
+		}
+		if seg != segments[i] {
+			return nil, false
+		}
+	}
+	return vars, true
+}
+
+func (s *Server) ping(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, common.NewPingResponse())
+}
+
+func (s *Server) version(w http.ResponseWriter, _ *http.Request, _ map[string]string) {
+	writeJSON(w, http.StatusOK, common.NewVersionResponse(Version))
+}
+
+func writeJSON(w http.ResponseWriter, statusCode int, body interface{}) {
+	w.Header().Set(clients.ContentType, clients.ContentTypeJSON)
+	w.WriteHeader(statusCode)
+	_ = json.NewEncoder(w).Encode(body)
+}
+
+func writeError(w http.ResponseWriter, requestId string, err errors.EdgeX) {
+	writeJSON(w, err.Code(), common.NewBaseResponse(requestId, err.Message(), err.Code()))
+}
+
+func decodeJSON(r *http.Request, v interface{}) errors.EdgeX {
+	defer r.Body.Close()
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the request body", err)
+	}
+	return nil
+}
+
+// parseOffsetAndLimit parses the offset and limit query strings, where the limit -1 means no limit
+func parseOffsetAndLimit(r *http.Request) (offset int, limit int, err errors.EdgeX) {
+	offset, limit = v2.DefaultOffset, v2.DefaultLimit
+	query := r.URL.Query()
+	if v := query.Get(v2.Offset); v != "" {
+		o, parseErr := strconv.Atoi(v)
+		if parseErr != nil || o < 0 {
+			return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid offset %s", v), parseErr)
+		}
+		offset = o
+	}
+	if v := query.Get(v2.Limit); v != "" {
+		l, parseErr := strconv.Atoi(v)
+		if parseErr != nil || l < -1 {
+			return 0, 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid limit %s", v), parseErr)
+		}
+		limit = l
+	}
+	return offset, limit, nil
+}
+
+// pageRange returns the start and end indexes of the page within the total number of items
+func pageRange(total int, offset int, limit int) (int, int, errors.EdgeX) {
+	if offset > total {
+		return 0, 0, errors.NewCommonEdgeX(errors.KindRangeNotSatisfiable, fmt.Sprintf("offset %d exceeds the total count %d", offset, total), nil)
+	}
+	end := total
+	if limit >= 0 && offset+limit < total {
+		end = offset + limit
+	}
+	return offset, end, nil
+}
+
+// parseLabels parses the comma-separated labels query string
+func parseLabels(r *http.Request) []string {
+	v := r.URL.Query().Get(v2.Labels)
+	if v == "" {
+		return nil
+	}
+	return strings.Split(v, v2.CommaSeparator)
+}
+
+// hasAnyLabel checks whether the labels contain any of the expected labels, or the expected labels are empty
+func hasAnyLabel(labels []string, expected []string) bool {
+	if len(expected) == 0 {
+		return true
+	}
+	for _, e := range expected {
+		for _, l := range labels {
+			if e == l {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func parseIntVar(vars map[string]string, name string) (int64, errors.EdgeX) {
+	i, err := strconv.ParseInt(vars[name], 10, 64)
+	if err != nil {
+		return 0, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s %s", name, vars[name]), err)
+	}
+	return i, nil
+}
+
+func makeTimestamp() int64 {
+	return time.Now().UnixNano() / int64(time.Millisecond)
+}
+
+// sortedNames returns the names sorted by the Created timestamp, then by the name itself
+func sortedNames(created map[string]int64) []string {
+	names := make([]string, 0, len(created))
+	for name := range created {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if created[names[i]] != created[names[j]] {
+			return created[names[i]] < created[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	return names
+}
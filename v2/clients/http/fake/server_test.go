@@ -0,0 +1,389 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package fake
+
+import (
+	"context"
+	"io/ioutil"
+	"net/http"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/yaml.v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	clientHttp "github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+const (
+	testServiceName  = "test-service"
+	testProfileName  = "test-profile"
+	testDeviceName   = "test-device"
+	testWatcherName  = "test-watcher"
+	testResourceName = "temperature"
+)
+
+func testProfile() dtos.DeviceProfile {
+	return dtos.DeviceProfile{
+		Name:         testProfileName,
+		Manufacturer: "test-manufacturer",
+		Model:        "test-model",
+		Labels:       []string{"sensor"},
+		DeviceResources: []dtos.DeviceResource{{
+			Name:       testResourceName,
+			Properties: dtos.ResourceProperties{ValueType: v2.ValueTypeInt16, ReadWrite: v2.ReadWrite_R},
+		}},
+	}
+}
+
+func testService() dtos.DeviceService {
+	return dtos.DeviceService{Name: testServiceName, BaseAddress: "http://localhost:59900", AdminState: models.Unlocked}
+}
+
+func testDevice() dtos.Device {
+	return dtos.Device{
+		Name:           testDeviceName,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		ServiceName:    testServiceName,
+		ProfileName:    testProfileName,
+		Labels:         []string{"sensor"},
+		Protocols:      map[string]dtos.ProtocolProperties{"modbus-ip": {"Address": "localhost"}},
+	}
+}
+
+func testWatcher() dtos.ProvisionWatcher {
+	return dtos.ProvisionWatcher{
+		Name:        testWatcherName,
+		Identifiers: map[string]string{"address": "localhost"},
+		ServiceName: testServiceName,
+		ProfileName: testProfileName,
+		AdminState:  models.Unlocked,
+	}
+}
+
+// newPopulatedServer creates the fake server with a device service, a device profile and a device
+func newPopulatedServer(t *testing.T) *Server {
+	s := NewServer()
+	ctx := context.Background()
+	serviceRes, err := clientHttp.NewDeviceServiceClient(s.URL).Add(ctx, []requests.AddDeviceServiceRequest{requests.NewAddDeviceServiceRequest(testService())})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, serviceRes[0].StatusCode)
+	profileRes, err := clientHttp.NewDeviceProfileClient(s.URL).Add(ctx, []requests.DeviceProfileRequest{requests.NewDeviceProfileRequest(testProfile())})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, profileRes[0].StatusCode)
+	deviceRes, err := clientHttp.NewDeviceClient(s.URL).Add(ctx, []requests.AddDeviceRequest{requests.NewAddDeviceRequest(testDevice())})
+	require.NoError(t, err)
+	require.Equal(t, http.StatusCreated, deviceRes[0].StatusCode)
+	return s
+}
+
+func TestServer_Common(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	client := clientHttp.NewCommonClient(s.URL)
+	_, err := client.Ping(context.Background())
+	require.NoError(t, err)
+	res, err := client.Version(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, Version, res.Version)
+}
+
+func TestServer_Device(t *testing.T) {
+	s := newPopulatedServer(t)
+	defer s.Close()
+	ctx := context.Background()
+	client := clientHttp.NewDeviceClient(s.URL)
+
+	res, err := client.DeviceByName(ctx, testDeviceName)
+	require.NoError(t, err)
+	assert.NotEmpty(t, res.Device.Id)
+	assert.Equal(t, testServiceName, res.Device.ServiceName)
+
+	duplicated := testDevice()
+	missingProfile := testDevice()
+	missingProfile.Name = "another-device"
+	missingProfile.ProfileName = "unknown-profile"
+	addRes, err := client.Add(ctx, []requests.AddDeviceRequest{requests.NewAddDeviceRequest(duplicated), requests.NewAddDeviceRequest(missingProfile)})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusConflict, addRes[0].StatusCode)
+	assert.Equal(t, http.StatusNotFound, addRes[1].StatusCode)
+
+	name := testDeviceName
+	newName := "renamed-device"
+	locked := models.Locked
+	updateRes, err := client.Update(ctx, []requests.UpdateDeviceRequest{requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name, AdminState: &locked})})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, updateRes[0].StatusCode)
+	updateRes, err = client.Update(ctx, []requests.UpdateDeviceRequest{requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Id: &res.Device.Id, Name: &newName})})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, updateRes[0].StatusCode)
+
+	_, err = client.DeviceNameExists(ctx, testDeviceName)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+	res, err = client.DeviceByName(ctx, newName)
+	require.NoError(t, err)
+	assert.Equal(t, models.Locked, res.Device.AdminState)
+	assert.Equal(t, testProfileName, res.Device.ProfileName)
+
+	multiRes, err := client.AllDevices(ctx, []string{"sensor"}, 0, -1)
+	require.NoError(t, err)
+	assert.Len(t, multiRes.Devices, 1)
+	multiRes, err = client.AllDevices(ctx, []string{"unknown"}, 0, -1)
+	require.NoError(t, err)
+	assert.Len(t, multiRes.Devices, 0)
+	multiRes, err = client.DevicesByProfileName(ctx, testProfileName, 0, 20)
+	require.NoError(t, err)
+	assert.Len(t, multiRes.Devices, 1)
+	multiRes, err = client.DevicesByServiceName(ctx, testServiceName, 0, 20)
+	require.NoError(t, err)
+	assert.Len(t, multiRes.Devices, 1)
+	_, err = client.DevicesByServiceName(ctx, testServiceName, 2, 20)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindRangeNotSatisfiable, errors.Kind(err))
+
+	_, err = client.DeleteDeviceByName(ctx, newName)
+	require.NoError(t, err)
+	_, err = client.DeleteDeviceByName(ctx, newName)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestServer_DeviceFieldMask(t *testing.T) {
+	s := newPopulatedServer(t)
+	defer s.Close()
+	ctx := context.Background()
+	client := clientHttp.NewDeviceClient(s.URL)
+
+	name := testDeviceName
+	clearLabels := requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name})
+	clearLabels.FieldMask = []string{"labels"}
+	clearService := requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name})
+	clearService.FieldMask = []string{"serviceName"}
+	updateRes, err := client.Update(ctx, []requests.UpdateDeviceRequest{clearLabels, clearService})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, updateRes[0].StatusCode)
+	assert.Equal(t, http.StatusBadRequest, updateRes[1].StatusCode)
+
+	res, err := client.DeviceByName(ctx, testDeviceName)
+	require.NoError(t, err)
+	assert.Empty(t, res.Device.Labels)
+	assert.Equal(t, testServiceName, res.Device.ServiceName)
+}
+
+func TestServer_DeviceProfile(t *testing.T) {
+	s := newPopulatedServer(t)
+	defer s.Close()
+	ctx := context.Background()
+	client := clientHttp.NewDeviceProfileClient(s.URL)
+
+	multiRes, err := client.DeviceProfilesByManufacturerAndModel(ctx, "test-manufacturer", "test-model", 0, 20)
+	require.NoError(t, err)
+	assert.Len(t, multiRes.Profiles, 1)
+	multiRes, err = client.DeviceProfilesByModel(ctx, "unknown-model", 0, 20)
+	require.NoError(t, err)
+	assert.Len(t, multiRes.Profiles, 0)
+	resourceRes, err := client.DeviceResourceByProfileNameAndResourceName(ctx, testProfileName, testResourceName)
+	require.NoError(t, err)
+	assert.Equal(t, testResourceName, resourceRes.Resource.Name)
+
+	_, err = client.DeleteByName(ctx, testProfileName)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(err), "the profile in use should not be deleted")
+
+	profile := testProfile()
+	profile.Name = "yaml-profile"
+	data, marshalErr := yaml.Marshal(profile)
+	require.NoError(t, marshalErr)
+	yamlFile := filepath.Join(t.TempDir(), "profile.yaml")
+	require.NoError(t, ioutil.WriteFile(yamlFile, data, 0600))
+	addRes, err := client.AddByYaml(ctx, yamlFile)
+	require.NoError(t, err)
+	assert.NotEmpty(t, addRes.Id)
+	_, err = client.AddByYaml(ctx, yamlFile)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+
+	profile.Description = "updated"
+	updateRes, err := client.Update(ctx, []requests.DeviceProfileRequest{requests.NewDeviceProfileRequest(profile)})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, updateRes[0].StatusCode)
+	res, err := client.DeviceProfileByName(ctx, profile.Name)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", res.Profile.Description)
+	assert.Equal(t, addRes.Id, res.Profile.Id)
+
+	_, err = client.DeleteByName(ctx, profile.Name)
+	require.NoError(t, err)
+}
+
+func TestServer_DeviceServiceAndProvisionWatcher(t *testing.T) {
+	s := newPopulatedServer(t)
+	defer s.Close()
+	ctx := context.Background()
+	serviceClient := clientHttp.NewDeviceServiceClient(s.URL)
+	watcherClient := clientHttp.NewProvisionWatcherClient(s.URL)
+
+	addRes, err := watcherClient.Add(ctx, []requests.AddProvisionWatcherRequest{requests.NewAddProvisionWatcherRequest(testWatcher())})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusCreated, addRes[0].StatusCode)
+
+	name := testWatcherName
+	unknownService := "unknown-service"
+	updateRes, err := watcherClient.Update(ctx, []requests.UpdateProvisionWatcherRequest{requests.NewUpdateProvisionWatcherRequest(dtos.UpdateProvisionWatcher{Name: &name, ServiceName: &unknownService})})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusNotFound, updateRes[0].StatusCode)
+
+	multiRes, err := watcherClient.ProvisionWatchersByServiceName(ctx, testServiceName, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, multiRes.ProvisionWatchers, 1)
+	assert.Equal(t, testWatcherName, multiRes.ProvisionWatchers[0].Name)
+
+	description := "updated"
+	serviceName := testServiceName
+	serviceUpdateRes, err := serviceClient.Update(ctx, []requests.UpdateDeviceServiceRequest{requests.NewUpdateDeviceServiceRequest(dtos.UpdateDeviceService{Name: &serviceName, Description: &description})})
+	require.NoError(t, err)
+	assert.Equal(t, http.StatusOK, serviceUpdateRes[0].StatusCode)
+	serviceRes, err := serviceClient.DeviceServiceByName(ctx, testServiceName)
+	require.NoError(t, err)
+	assert.Equal(t, description, serviceRes.Service.Description)
+
+	_, err = serviceClient.DeleteByName(ctx, testServiceName)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+
+	_, err = watcherClient.DeleteProvisionWatcherByName(ctx, testWatcherName)
+	require.NoError(t, err)
+	_, err = clientHttp.NewDeviceClient(s.URL).DeleteDeviceByName(ctx, testDeviceName)
+	require.NoError(t, err)
+	_, err = serviceClient.DeleteByName(ctx, testServiceName)
+	require.NoError(t, err)
+	allRes, err := serviceClient.AllDeviceServices(ctx, nil, 0, 20)
+	require.NoError(t, err)
+	assert.Len(t, allRes.Services, 0)
+}
+
+func TestServer_EventAndReading(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	ctx := context.Background()
+	eventClient := clientHttp.NewEventClient(s.URL)
+	readingClient := clientHttp.NewReadingClient(s.URL)
+
+	oldEvent := dtos.NewEvent(testProfileName, testDeviceName, testResourceName)
+	require.NoError(t, oldEvent.AddSimpleReading(testResourceName, v2.ValueTypeInt16, int16(20)))
+	oldEvent.Origin = time.Now().Add(-time.Hour).UnixNano()
+	newEvent := dtos.NewEvent(testProfileName, testDeviceName, "image")
+	newEvent.AddBinaryReading("image", []byte{0x01, 0x02}, "image/png")
+	otherEvent := dtos.NewEvent(testProfileName, "other-device", testResourceName)
+	require.NoError(t, otherEvent.AddSimpleReading(testResourceName, v2.ValueTypeInt16, int16(21)))
+	for _, e := range []dtos.Event{oldEvent, newEvent, otherEvent} {
+		res, err := eventClient.Add(ctx, requests.NewAddEventRequest(e))
+		require.NoError(t, err)
+		assert.Equal(t, e.Id, res.Id)
+	}
+
+	countRes, err := eventClient.EventCount(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 3, countRes.Count)
+	countRes, err = eventClient.EventCountByDeviceName(ctx, testDeviceName)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, countRes.Count)
+	eventsRes, err := eventClient.EventsByDeviceName(ctx, testDeviceName, 0, 20)
+	require.NoError(t, err)
+	require.Len(t, eventsRes.Events, 2)
+	assert.Equal(t, newEvent.Id, eventsRes.Events[0].Id, "the newest event should be returned first")
+	assert.Equal(t, []byte{0x01, 0x02}, eventsRes.Events[0].Readings[0].BinaryValue)
+	eventsRes, err = eventClient.EventsByTimeRange(ctx, 0, int(oldEvent.Origin), 0, 20)
+	require.NoError(t, err)
+	require.Len(t, eventsRes.Events, 1)
+	assert.Equal(t, oldEvent.Id, eventsRes.Events[0].Id)
+
+	readingsRes, err := readingClient.ReadingsByResourceName(ctx, testResourceName, 0, 20)
+	require.NoError(t, err)
+	assert.Len(t, readingsRes.Readings, 2)
+	readingCountRes, err := readingClient.ReadingCountByDeviceName(ctx, "other-device")
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, readingCountRes.Count)
+
+	_, err = eventClient.DeleteByAge(ctx, int(time.Minute))
+	require.NoError(t, err)
+	countRes, err = eventClient.EventCount(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, countRes.Count)
+	_, err = eventClient.DeleteByDeviceName(ctx, "other-device")
+	require.NoError(t, err)
+	readingCountRes, err = readingClient.ReadingCount(ctx)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, readingCountRes.Count)
+}
+
+func TestServer_UnknownRoute(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	res, err := http.Get(s.URL + "/api/v2/unknown")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+
+	req, err := http.NewRequest(http.MethodPut, s.URL+v2.ApiPingRoute, nil)
+	require.NoError(t, err)
+	res2, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer res2.Body.Close()
+	assert.Equal(t, http.StatusMethodNotAllowed, res2.StatusCode)
+}
+
+func TestRoute_Match(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+	s.handle(http.MethodGet, "/test/{name}", nil)
+	rt := s.routes[len(s.routes)-1]
+
+	tests := []struct {
+		name     string
+		param    string
+		expected string
+	}{
+		{"plain", "device1", "device1"},
+		{"escaped slash", "a%2Fb", "a/b"},
+		{"escaped space", v2.EscapePathParam("a b"), "a b"},
+		{"literal plus", "a+b", "a+b"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			vars, ok := rt.match([]string{"test", testCase.param})
+			require.True(t, ok)
+			assert.Equal(t, testCase.expected, vars["name"])
+		})
+	}
+	_, ok := rt.match([]string{"test", "a%2"})
+	assert.False(t, ok)
+}
+
+func TestServer_EscapedSlash(t *testing.T) {
+	s := NewServer()
+	defer s.Close()
+
+	// The escaped slash stays in the path variable instead of splitting the path into an unknown route
+	res, err := http.Get(s.URL + v2.ApiDeviceRoute + "/" + v2.Name + "/a%2Fb")
+	require.NoError(t, err)
+	defer res.Body.Close()
+	assert.Equal(t, http.StatusNotFound, res.StatusCode)
+	body, err := ioutil.ReadAll(res.Body)
+	require.NoError(t, err)
+	assert.Contains(t, string(body), "a/b")
+}
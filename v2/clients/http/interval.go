@@ -8,7 +8,6 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
@@ -67,8 +66,11 @@ func (client IntervalClient) AllIntervals(ctx context.Context, offset int, limit
 // IntervalByName query the interval by name
 func (client IntervalClient) IntervalByName(ctx context.Context, name string) (
 	res responses.IntervalResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiIntervalRoute, v2.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, client.baseUrl, path, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiIntervalByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -78,8 +80,11 @@ func (client IntervalClient) IntervalByName(ctx context.Context, name string) (
 // DeleteIntervalByName delete the interval by name
 func (client IntervalClient) DeleteIntervalByName(ctx context.Context, name string) (
 	res common.BaseResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiIntervalRoute, v2.Name, url.QueryEscape(name))
-	err = utils.DeleteRequest(ctx, &res, client.baseUrl, path)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiIntervalByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
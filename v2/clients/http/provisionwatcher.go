@@ -8,7 +8,6 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 	"strings"
 
@@ -66,8 +65,11 @@ func (pwc ProvisionWatcherClient) AllProvisionWatchers(ctx context.Context, labe
 }
 
 func (pwc ProvisionWatcherClient) ProvisionWatcherByName(ctx context.Context, name string) (res responses.ProvisionWatcherResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiProvisionWatcherRoute, v2.Name, url.QueryEscape(name))
-	err = utils.GetRequest(ctx, &res, pwc.baseUrl, path, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiProvisionWatcherByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, pwc.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -76,8 +78,11 @@ func (pwc ProvisionWatcherClient) ProvisionWatcherByName(ctx context.Context, na
 }
 
 func (pwc ProvisionWatcherClient) DeleteProvisionWatcherByName(ctx context.Context, name string) (res common.BaseResponse, err errors.EdgeX) {
-	path := path.Join(v2.ApiProvisionWatcherRoute, v2.Name, url.QueryEscape(name))
-	err = utils.DeleteRequest(ctx, &res, pwc.baseUrl, path)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiProvisionWatcherByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, pwc.baseUrl, requestPath)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -86,7 +91,10 @@ func (pwc ProvisionWatcherClient) DeleteProvisionWatcherByName(ctx context.Conte
 }
 
 func (pwc ProvisionWatcherClient) ProvisionWatchersByProfileName(ctx context.Context, name string, offset int, limit int) (res responses.MultiProvisionWatchersResponse, err errors.EdgeX) {
-	requestPath := path.Join(v2.ApiProvisionWatcherRoute, v2.Profile, v2.Name, url.QueryEscape(name))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiProvisionWatcherByProfileNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
@@ -99,7 +107,10 @@ func (pwc ProvisionWatcherClient) ProvisionWatchersByProfileName(ctx context.Con
 }
 
 func (pwc ProvisionWatcherClient) ProvisionWatchersByServiceName(ctx context.Context, name string, offset int, limit int) (res responses.MultiProvisionWatchersResponse, err errors.EdgeX) {
-	requestPath := path.Join(v2.ApiProvisionWatcherRoute, v2.Service, v2.Name, url.QueryEscape(name))
+	requestPath, err := v2.NewRouteBuilder(v2.ApiProvisionWatcherByServiceNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
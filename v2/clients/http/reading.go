@@ -8,7 +8,6 @@ package http
 import (
 	"context"
 	"net/url"
-	"path"
 	"strconv"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
@@ -52,9 +51,12 @@ func (rc readingCLient) ReadingCount(ctx context.Context) (common.CountResponse,
 }
 
 func (rc readingCLient) ReadingCountByDeviceName(ctx context.Context, name string) (common.CountResponse, errors.EdgeX) {
-	requestPath := path.Join(v2.ApiReadingCountRoute, v2.Device, v2.Name, url.QueryEscape(name))
 	res := common.CountResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, nil)
+	requestPath, err := v2.NewRouteBuilder(v2.ApiReadingCountByDeviceNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, nil)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -62,12 +64,15 @@ func (rc readingCLient) ReadingCountByDeviceName(ctx context.Context, name strin
 }
 
 func (rc readingCLient) ReadingsByDeviceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(v2.ApiReadingRoute, v2.Device, v2.Name, url.QueryEscape(name))
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := v2.NewRouteBuilder(v2.ApiReadingByDeviceNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -75,12 +80,15 @@ func (rc readingCLient) ReadingsByDeviceName(ctx context.Context, name string, o
 }
 
 func (rc readingCLient) ReadingsByResourceName(ctx context.Context, name string, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(v2.ApiReadingRoute, v2.ResourceName, url.QueryEscape(name))
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := v2.NewRouteBuilder(v2.ApiReadingByResourceNameRoute).SetParam(v2.ResourceName, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -88,12 +96,15 @@ func (rc readingCLient) ReadingsByResourceName(ctx context.Context, name string,
 }
 
 func (rc readingCLient) ReadingsByTimeRange(ctx context.Context, start, end, offset, limit int) (responses.MultiReadingsResponse, errors.EdgeX) {
-	requestPath := path.Join(v2.ApiReadingRoute, v2.Start, strconv.Itoa(start), v2.End, strconv.Itoa(end))
+	res := responses.MultiReadingsResponse{}
+	requestPath, err := v2.NewRouteBuilder(v2.ApiReadingByTimeRangeRoute).SetIntParam(v2.Start, start).SetIntParam(v2.End, end).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
 	requestParams := url.Values{}
 	requestParams.Set(v2.Offset, strconv.Itoa(offset))
 	requestParams.Set(v2.Limit, strconv.Itoa(limit))
-	res := responses.MultiReadingsResponse{}
-	err := utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
+	err = utils.GetRequest(ctx, &res, rc.baseUrl, requestPath, requestParams)
 	if err != nil {
 		return res, errors.NewCommonEdgeXWrapper(err)
 	}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/http/utils"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+)
+
+type SubscriptionClient struct {
+	baseUrl string
+}
+
+// NewSubscriptionClient creates an instance of SubscriptionClient
+func NewSubscriptionClient(baseUrl string) interfaces.SubscriptionClient {
+	return &SubscriptionClient{
+		baseUrl: baseUrl,
+	}
+}
+
+// Add adds new subscriptions
+func (client SubscriptionClient) Add(ctx context.Context, reqs []requests.AddSubscriptionRequest) (
+	res []common.BaseWithIdResponse, err errors.EdgeX) {
+	err = utils.PostRequestWithRawData(ctx, &res, client.baseUrl+v2.ApiSubscriptionRoute, reqs)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// Update updates subscriptions
+func (client SubscriptionClient) Update(ctx context.Context, reqs []requests.UpdateSubscriptionRequest) (
+	res []common.BaseResponse, err errors.EdgeX) {
+	err = utils.PatchRequest(ctx, &res, client.baseUrl+v2.ApiSubscriptionRoute, reqs)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// AllSubscriptions query the subscriptions with offset, limit
+func (client SubscriptionClient) AllSubscriptions(ctx context.Context, offset int, limit int) (
+	res responses.MultiSubscriptionsResponse, err errors.EdgeX) {
+	requestParams := url.Values{}
+	requestParams.Set(v2.Offset, strconv.Itoa(offset))
+	requestParams.Set(v2.Limit, strconv.Itoa(limit))
+	err = utils.GetRequest(ctx, &res, client.baseUrl, v2.ApiAllSubscriptionRoute, requestParams)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// SubscriptionByName query the subscription by name
+func (client SubscriptionClient) SubscriptionByName(ctx context.Context, name string) (
+	res responses.SubscriptionResponse, err errors.EdgeX) {
+	requestPath, err := v2.NewRouteBuilder(v2.ApiSubscriptionByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.GetRequest(ctx, &res, client.baseUrl, requestPath, nil)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
+
+// DeleteSubscriptionByName delete the subscription by name
+func (client SubscriptionClient) DeleteSubscriptionByName(ctx context.Context, name string) (
+	res common.BaseResponse, err errors.EdgeX) {
+	requestPath, err := v2.NewRouteBuilder(v2.ApiSubscriptionByNameRoute).SetParam(v2.Name, name).Build()
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	err = utils.DeleteRequest(ctx, &res, client.baseUrl, requestPath)
+	if err != nil {
+		return res, errors.NewCommonEdgeXWrapper(err)
+	}
+	return res, nil
+}
@@ -0,0 +1,91 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package http
+
+import (
+	"context"
+	"net/http"
+	"path"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddSubscriptions(t *testing.T) {
+	ts := newTestServer(http.MethodPost, v2.ApiSubscriptionRoute, []common.BaseWithIdResponse{})
+	defer ts.Close()
+	dto := dtos.Subscription{
+		Name:       TestSubscriptionName,
+		Channels:   []dtos.Address{dtos.NewEmailAddress([]string{"test@example.com"})},
+		Receiver:   TestReceiver,
+		Categories: []string{"health-check"},
+		AdminState: models.Unlocked,
+	}
+	request := []requests.AddSubscriptionRequest{requests.NewAddSubscriptionRequest(dto)}
+	client := NewSubscriptionClient(ts.URL)
+
+	res, err := client.Add(context.Background(), request)
+
+	require.NoError(t, err)
+	assert.IsType(t, []common.BaseWithIdResponse{}, res)
+}
+
+func TestPatchSubscriptions(t *testing.T) {
+	ts := newTestServer(http.MethodPatch, v2.ApiSubscriptionRoute, []common.BaseResponse{})
+	defer ts.Close()
+	name := TestSubscriptionName
+	dto := dtos.UpdateSubscription{Name: &name}
+	request := []requests.UpdateSubscriptionRequest{requests.NewUpdateSubscriptionRequest(dto)}
+	client := NewSubscriptionClient(ts.URL)
+
+	res, err := client.Update(context.Background(), request)
+
+	require.NoError(t, err)
+	assert.IsType(t, []common.BaseResponse{}, res)
+}
+
+func TestQueryAllSubscriptions(t *testing.T) {
+	ts := newTestServer(http.MethodGet, v2.ApiAllSubscriptionRoute, responses.MultiSubscriptionsResponse{})
+	defer ts.Close()
+	client := NewSubscriptionClient(ts.URL)
+
+	res, err := client.AllSubscriptions(context.Background(), 0, 10)
+
+	require.NoError(t, err)
+	assert.IsType(t, responses.MultiSubscriptionsResponse{}, res)
+}
+
+func TestQuerySubscriptionByName(t *testing.T) {
+	path := path.Join(v2.ApiSubscriptionRoute, v2.Name, TestSubscriptionName)
+	ts := newTestServer(http.MethodGet, path, responses.SubscriptionResponse{})
+	defer ts.Close()
+
+	client := NewSubscriptionClient(ts.URL)
+
+	res, err := client.SubscriptionByName(context.Background(), TestSubscriptionName)
+	require.NoError(t, err)
+	assert.IsType(t, responses.SubscriptionResponse{}, res)
+}
+
+func TestDeleteSubscriptionByName(t *testing.T) {
+	path := path.Join(v2.ApiSubscriptionRoute, v2.Name, TestSubscriptionName)
+	ts := newTestServer(http.MethodDelete, path, common.BaseResponse{})
+	defer ts.Close()
+	client := NewSubscriptionClient(ts.URL)
+
+	res, err := client.DeleteSubscriptionByName(context.Background(), TestSubscriptionName)
+
+	require.NoError(t, err)
+	assert.IsType(t, common.BaseResponse{}, res)
+}
@@ -15,6 +15,7 @@ import (
 	"mime/multipart"
 	"net/http"
 	"net/url"
+	"os"
 	"path/filepath"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
@@ -24,19 +25,16 @@ import (
 )
 
 // FromContext allows for the retrieval of the specified key's value from the supplied Context.
+// Both the typed clients.ContextKey and the plain string key are accepted.
 // If the value is not found, an empty string is returned.
 func FromContext(ctx context.Context, key string) string {
-	hdr, ok := ctx.Value(key).(string)
-	if !ok {
-		hdr = ""
-	}
-	return hdr
+	return clients.ValueFromContext(ctx, key)
 }
 
 // correlatedId gets Correlation ID from supplied context. If no Correlation ID header is
 // present in the supplied context, one will be created along with a value.
 func correlatedId(ctx context.Context) string {
-	correlation := FromContext(ctx, clients.CorrelationHeader)
+	correlation := clients.CorrelationIDFrom(ctx)
 	if len(correlation) == 0 {
 		correlation = uuid.New().String()
 	}
@@ -70,7 +68,13 @@ func createRequest(ctx context.Context, httpMethod string, baseUrl string, reque
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindClientError, "fail to parse baseUrl", err)
 	}
-	u.Path = requestPath
+	// The path params of the request path are already escaped, so the path is kept as is rather than escaped again
+	path, err := url.PathUnescape(requestPath)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindClientError, "fail to parse the request path", err)
+	}
+	u.Path = path
+	u.RawPath = requestPath
 	if requestParams != nil {
 		u.RawQuery = requestParams.Encode()
 	}
@@ -119,32 +123,63 @@ func createRequestWithEncodedData(ctx context.Context, httpMethod string, url st
 
 // createRequestFromFilePath creates multipart/form-data request with the specified file
 func createRequestFromFilePath(ctx context.Context, httpMethod string, url string, filePath string) (*http.Request, errors.EdgeX) {
-	fileContents, err := ioutil.ReadFile(filePath)
+	file, err := os.Open(filePath)
 	if err != nil {
 		return nil, errors.NewCommonEdgeX(errors.KindClientError, fmt.Sprintf("fail to read file from %s", filePath), err)
 	}
-
-	body := &bytes.Buffer{}
-	writer := multipart.NewWriter(body)
-	formFileWriter, err := writer.CreateFormFile("file", filepath.Base(filePath))
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindClientError, "fail to create form data", err)
-	}
-	_, err = io.Copy(formFileWriter, bytes.NewReader(fileContents))
-	if err != nil {
-		return nil, errors.NewCommonEdgeX(errors.KindClientError, "fail to copy file to form data", err)
+	req, edgexErr := createRequestFromReader(ctx, httpMethod, url, filepath.Base(filePath), file)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
 	}
-	writer.Close()
+	return req, nil
+}
 
+// createRequestFromReader creates multipart/form-data request with the file content read from the reader. The
+// multipart body is streamed to the request while sending it instead of being buffered in memory, and the reader
+// is closed once it's sent if it's an io.Closer.
+func createRequestFromReader(ctx context.Context, httpMethod string, url string, fileName string, content io.Reader) (*http.Request, errors.EdgeX) {
+	body, bodyWriter := io.Pipe()
+	writer := multipart.NewWriter(bodyWriter)
+	closer, isCloser := content.(io.Closer)
 	req, err := http.NewRequest(httpMethod, url, body)
 	if err != nil {
+		if isCloser {
+			closer.Close()
+		}
 		return nil, errors.NewCommonEdgeX(errors.KindClientError, "failed to create a http request", err)
 	}
 	req.Header.Set(clients.ContentType, writer.FormDataContentType())
 	req.Header.Set(clients.CorrelationHeader, correlatedId(ctx))
+
+	go func() {
+		err := writeFormFile(writer, fileName, content)
+		// The content is closed before the end of the body, so it's already closed once the response arrives
+		if isCloser {
+			closer.Close()
+		}
+		if err != nil {
+			bodyWriter.CloseWithError(err)
+			return
+		}
+		bodyWriter.Close()
+	}()
 	return req, nil
 }
 
+func writeFormFile(writer *multipart.Writer, fileName string, content io.Reader) errors.EdgeX {
+	formFileWriter, err := writer.CreateFormFile("file", fileName)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindClientError, "fail to create form data", err)
+	}
+	if _, err = io.Copy(formFileWriter, content); err != nil {
+		return errors.NewCommonEdgeX(errors.KindClientError, "fail to copy file to form data", err)
+	}
+	if err = writer.Close(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindClientError, "fail to close form data", err)
+	}
+	return nil
+}
+
 // sendRequest will make a request with raw data to the specified URL.
 // It returns the body as a byte array if successful and an error otherwise.
 func sendRequest(ctx context.Context, req *http.Request) ([]byte, errors.EdgeX) {
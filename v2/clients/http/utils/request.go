@@ -9,6 +9,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"io"
 	"net/http"
 	"net/url"
 
@@ -200,6 +201,52 @@ func PutByFileRequest(
 	return nil
 }
 
+// PostByReaderRequest makes the post file request with the file content read from the reader and return the body
+func PostByReaderRequest(
+	ctx context.Context,
+	returnValuePointer interface{},
+	url string,
+	fileName string,
+	content io.Reader) errors.EdgeX {
+
+	req, err := createRequestFromReader(ctx, http.MethodPost, url, fileName, content)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	res, err := sendRequest(ctx, req)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := json.Unmarshal(res, returnValuePointer); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	return nil
+}
+
+// PutByReaderRequest makes the put file request with the file content read from the reader and return the body
+func PutByReaderRequest(
+	ctx context.Context,
+	returnValuePointer interface{},
+	url string,
+	fileName string,
+	content io.Reader) errors.EdgeX {
+
+	req, err := createRequestFromReader(ctx, http.MethodPut, url, fileName, content)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+
+	res, err := sendRequest(ctx, req)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := json.Unmarshal(res, returnValuePointer); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the response body", err)
+	}
+	return nil
+}
+
 // DeleteRequest makes the delete request and return the body
 func DeleteRequest(ctx context.Context, returnValuePointer interface{}, baseUrl string, requestPath string) errors.EdgeX {
 	req, err := createRequest(ctx, http.MethodDelete, baseUrl, requestPath, nil)
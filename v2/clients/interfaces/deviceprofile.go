@@ -7,8 +7,10 @@ package interfaces
 
 import (
 	"context"
+	"io"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
@@ -24,6 +26,19 @@ type DeviceProfileClient interface {
 	AddByYaml(ctx context.Context, yamlFilePath string) (common.BaseWithIdResponse, errors.EdgeX)
 	// UpdateByYaml updates profile by uploading a file in YAML format
 	UpdateByYaml(ctx context.Context, yamlFilePath string) (common.BaseResponse, errors.EdgeX)
+	// AddByYamlReader adds new profile by uploading the YAML content read from the reader, where the fileName is
+	// the name of the uploaded file
+	AddByYamlReader(ctx context.Context, fileName string, content io.Reader) (common.BaseWithIdResponse, errors.EdgeX)
+	// UpdateByYamlReader updates profile by uploading the YAML content read from the reader
+	UpdateByYamlReader(ctx context.Context, fileName string, content io.Reader) (common.BaseResponse, errors.EdgeX)
+	// AddByYamlBytes adds new profile by uploading the YAML content
+	AddByYamlBytes(ctx context.Context, fileName string, content []byte) (common.BaseWithIdResponse, errors.EdgeX)
+	// UpdateByYamlBytes updates profile by uploading the YAML content
+	UpdateByYamlBytes(ctx context.Context, fileName string, content []byte) (common.BaseResponse, errors.EdgeX)
+	// AddByYamlFiles adds new profiles by uploading the files in YAML format one by one, and returns a response per file
+	AddByYamlFiles(ctx context.Context, files []clients.File) ([]common.BaseWithIdResponse, errors.EdgeX)
+	// UpdateByYamlFiles updates profiles by uploading the files in YAML format one by one, and returns a response per file
+	UpdateByYamlFiles(ctx context.Context, files []clients.File) ([]common.BaseResponse, errors.EdgeX)
 	// DeleteByName deletes profile by name
 	DeleteByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
 	// DeviceProfileByName queries profile by name
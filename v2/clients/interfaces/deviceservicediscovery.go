@@ -0,0 +1,28 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+)
+
+// DeviceServiceDiscoveryClient defines the interface for interactions with the device discovery endpoints on the EdgeX Foundry device services.
+type DeviceServiceDiscoveryClient interface {
+	// TriggerDiscovery invokes device service's discovery API to start the device discovery, and returns the Discovery
+	// which is running in the background
+	TriggerDiscovery(ctx context.Context, baseUrl string, request requests.DiscoveryRequest) (responses.DiscoveryResponse, errors.EdgeX)
+	// DiscoveryById returns the progress of the device discovery by id
+	DiscoveryById(ctx context.Context, baseUrl string, id string) (responses.DiscoveryResponse, errors.EdgeX)
+	// DiscoveredDevices returns the devices found by the device discovery with the given id.
+	// The result can be limited in a certain range by specifying the offset and limit parameters.
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	DiscoveredDevices(ctx context.Context, baseUrl string, id string, offset int, limit int) (responses.MultiDiscoveredDevicesResponse, errors.EdgeX)
+}
@@ -5,10 +5,14 @@ package mocks
 import (
 	context "context"
 
+	clients "github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+
 	common "github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 
 	errors "github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
 	requests "github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
@@ -69,6 +73,77 @@ func (_m *DeviceProfileClient) AddByYaml(ctx context.Context, yamlFilePath strin
 	return r0, r1
 }
 
+// AddByYamlBytes provides a mock function with given fields: ctx, fileName, content
+func (_m *DeviceProfileClient) AddByYamlBytes(ctx context.Context, fileName string, content []byte) (common.BaseWithIdResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, fileName, content)
+
+	var r0 common.BaseWithIdResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) common.BaseWithIdResponse); ok {
+		r0 = rf(ctx, fileName, content)
+	} else {
+		r0 = ret.Get(0).(common.BaseWithIdResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) errors.EdgeX); ok {
+		r1 = rf(ctx, fileName, content)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddByYamlFiles provides a mock function with given fields: ctx, files
+func (_m *DeviceProfileClient) AddByYamlFiles(ctx context.Context, files []clients.File) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, files)
+
+	var r0 []common.BaseWithIdResponse
+	if rf, ok := ret.Get(0).(func(context.Context, []clients.File) []common.BaseWithIdResponse); ok {
+		r0 = rf(ctx, files)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.BaseWithIdResponse)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, []clients.File) errors.EdgeX); ok {
+		r1 = rf(ctx, files)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// AddByYamlReader provides a mock function with given fields: ctx, fileName, content
+func (_m *DeviceProfileClient) AddByYamlReader(ctx context.Context, fileName string, content io.Reader) (common.BaseWithIdResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, fileName, content)
+
+	var r0 common.BaseWithIdResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) common.BaseWithIdResponse); ok {
+		r0 = rf(ctx, fileName, content)
+	} else {
+		r0 = ret.Get(0).(common.BaseWithIdResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader) errors.EdgeX); ok {
+		r1 = rf(ctx, fileName, content)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
 // AllDeviceProfiles provides a mock function with given fields: ctx, labels, offset, limit
 func (_m *DeviceProfileClient) AllDeviceProfiles(ctx context.Context, labels []string, offset int, limit int) (responses.MultiDeviceProfilesResponse, errors.EdgeX) {
 	ret := _m.Called(ctx, labels, offset, limit)
@@ -277,3 +352,74 @@ func (_m *DeviceProfileClient) UpdateByYaml(ctx context.Context, yamlFilePath st
 
 	return r0, r1
 }
+
+// UpdateByYamlBytes provides a mock function with given fields: ctx, fileName, content
+func (_m *DeviceProfileClient) UpdateByYamlBytes(ctx context.Context, fileName string, content []byte) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, fileName, content)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, []byte) common.BaseResponse); ok {
+		r0 = rf(ctx, fileName, content)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, []byte) errors.EdgeX); ok {
+		r1 = rf(ctx, fileName, content)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateByYamlFiles provides a mock function with given fields: ctx, files
+func (_m *DeviceProfileClient) UpdateByYamlFiles(ctx context.Context, files []clients.File) ([]common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, files)
+
+	var r0 []common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, []clients.File) []common.BaseResponse); ok {
+		r0 = rf(ctx, files)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]common.BaseResponse)
+		}
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, []clients.File) errors.EdgeX); ok {
+		r1 = rf(ctx, files)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateByYamlReader provides a mock function with given fields: ctx, fileName, content
+func (_m *DeviceProfileClient) UpdateByYamlReader(ctx context.Context, fileName string, content io.Reader) (common.BaseResponse, errors.EdgeX) {
+	ret := _m.Called(ctx, fileName, content)
+
+	var r0 common.BaseResponse
+	if rf, ok := ret.Get(0).(func(context.Context, string, io.Reader) common.BaseResponse); ok {
+		r0 = rf(ctx, fileName, content)
+	} else {
+		r0 = ret.Get(0).(common.BaseResponse)
+	}
+
+	var r1 errors.EdgeX
+	if rf, ok := ret.Get(1).(func(context.Context, string, io.Reader) errors.EdgeX); ok {
+		r1 = rf(ctx, fileName, content)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(errors.EdgeX)
+		}
+	}
+
+	return r0, r1
+}
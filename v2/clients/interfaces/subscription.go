@@ -0,0 +1,32 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package interfaces
+
+import (
+	"context"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+)
+
+// SubscriptionClient defines the interface for interactions with the Subscription endpoint on the EdgeX Foundry support-notifications service.
+type SubscriptionClient interface {
+	// Add adds new subscriptions.
+	Add(ctx context.Context, reqs []requests.AddSubscriptionRequest) ([]common.BaseWithIdResponse, errors.EdgeX)
+	// Update updates subscriptions.
+	Update(ctx context.Context, reqs []requests.UpdateSubscriptionRequest) ([]common.BaseResponse, errors.EdgeX)
+	// AllSubscriptions returns all subscriptions.
+	// The result can be limited in a certain range by specifying the offset and limit parameters.
+	// offset: The number of items to skip before starting to collect the result set. Default is 0.
+	// limit: The number of items to return. Specify -1 will return all remaining items after offset. The maximum will be the MaxResultCount as defined in the configuration of service. Default is 20.
+	AllSubscriptions(ctx context.Context, offset int, limit int) (responses.MultiSubscriptionsResponse, errors.EdgeX)
+	// SubscriptionByName returns a subscription by name.
+	SubscriptionByName(ctx context.Context, name string) (responses.SubscriptionResponse, errors.EdgeX)
+	// DeleteSubscriptionByName deletes a subscription by name.
+	DeleteSubscriptionByName(ctx context.Context, name string) (common.BaseResponse, errors.EdgeX)
+}
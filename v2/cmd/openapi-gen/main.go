@@ -0,0 +1,56 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// openapi-gen writes the OpenAPI 3 documents of the v2 services, which are generated from the route table and the
+// DTOs of this module.
+//
+// Usage:
+//
+//	openapi-gen [-service core-metadata] [-version 2.0.0] [-o dir]
+//
+// Each document is written to <service>.json in the output directory, and all the services are generated if the
+// service is not specified.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/openapi"
+)
+
+func main() {
+	service := flag.String("service", "", "the service to generate, or all the services if empty")
+	version := flag.String("version", "2.0.0", "the version of the API")
+	output := flag.String("o", ".", "the output directory")
+	flag.Parse()
+
+	services := openapi.Services()
+	if *service != "" {
+		services = []string{*service}
+	}
+	for _, s := range services {
+		if err := write(s, *version, *output); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+}
+
+func write(service string, version string, dir string) error {
+	doc, err := openapi.Generate(service, version)
+	if err != nil {
+		return err
+	}
+	data, jsonErr := json.MarshalIndent(doc, "", "  ")
+	if jsonErr != nil {
+		return jsonErr
+	}
+	return ioutil.WriteFile(filepath.Join(dir, service+".json"), append(data, '\n'), 0644)
+}
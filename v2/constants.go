@@ -72,7 +72,7 @@ const (
 	ApiSubscriptionByReceiverRoute = ApiSubscriptionRoute + "/" + Receiver + "/{" + Receiver + "}"
 
 	ApiNotificationCleanupRoute            = ApiBase + "/cleanup"
-	ApiNotificationCleanupByAgeRoute       = ApiBase + "/" + Cleanup + "/" + Age + "/{" + Age + "}"
+	ApiNotificationCleanupByAgeRoute       = ApiNotificationCleanupRoute + "/" + Age + "/{" + Age + "}"
 	ApiNotificationRoute                   = ApiBase + "/notification"
 	ApiNotificationByTimeRangeRoute        = ApiNotificationRoute + "/" + Start + "/{" + Start + "}/" + End + "/{" + End + "}"
 	ApiNotificationByAgeRoute              = ApiNotificationRoute + "/" + Age + "/{" + Age + "}"
@@ -104,6 +104,8 @@ const (
 	ApiWatcherCallbackNameRoute = ApiBase + "/callback/watcher/name/{name}"
 	ApiServiceCallbackRoute     = ApiBase + "/callback/service"
 	ApiDiscoveryRoute           = ApiBase + "/discovery"
+	ApiDiscoveryByIdRoute       = ApiDiscoveryRoute + "/" + Id + "/{" + Id + "}"
+	ApiDiscoveredDeviceRoute    = ApiDiscoveryByIdRoute + "/" + Device
 
 	ApiIntervalRoute               = ApiBase + "/interval"
 	ApiAllIntervalRoute            = ApiIntervalRoute + "/" + All
@@ -218,9 +220,31 @@ const (
 // Constants for Address
 const (
 	// Type
-	REST  = "REST"
-	MQTT  = "MQTT"
-	EMAIL = "EMAIL"
+	REST    = "REST"
+	MQTT    = "MQTT"
+	EMAIL   = "EMAIL"
+	WEBHOOK = "WEBHOOK"
+)
+
+// Constants for Webhook address Scheme
+const (
+	SchemeHTTP  = "http"
+	SchemeHTTPS = "https"
+)
+
+// Constants for MQTT address AuthMode
+const (
+	AuthModeNone             = "none"
+	AuthModeUsernamePassword = "usernamepassword"
+	AuthModeCert             = "clientcert"
+	AuthModeCA               = "cacert"
+)
+
+// Constants for the State of device discovery
+const (
+	DiscoveryStateRunning   = "RUNNING"
+	DiscoveryStateCompleted = "COMPLETED"
+	DiscoveryStateFailed    = "FAILED"
 )
 
 // Constants for SMA Operation Action
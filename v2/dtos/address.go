@@ -6,13 +6,16 @@
 package dtos
 
 import (
+	"fmt"
+	"text/template"
+
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
 )
 
 type Address struct {
-	Type string `json:"type" validate:"oneof='REST' 'MQTT' 'EMAIL'"`
+	Type string `json:"type" validate:"oneof='REST' 'MQTT' 'EMAIL' 'WEBHOOK'"`
 
 	Host string `json:"host,omitempty" validate:"required_unless=Type EMAIL"`
 	Port int    `json:"port,omitempty" validate:"required_unless=Type EMAIL"`
@@ -20,6 +23,7 @@ type Address struct {
 	RESTAddress    `json:",inline" validate:"-"`
 	MQTTPubAddress `json:",inline" validate:"-"`
 	EmailAddress   `json:",inline" validate:"-"`
+	WebhookAddress `json:",inline" validate:"-"`
 }
 
 // Validate satisfies the Validator interface
@@ -40,6 +44,22 @@ func (a *Address) Validate() error {
 		if err != nil {
 			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid MQTTPubAddress.", err)
 		}
+		if a.AuthMode != "" && a.AuthMode != v2.AuthModeNone && a.SecretPath == "" {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid MQTTPubAddress, secretPath is required for the %s authMode.", a.AuthMode), nil)
+		}
+		break
+	case v2.WEBHOOK:
+		err = v2.Validate(a.RESTAddress)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid WebhookAddress.", err)
+		}
+		err = v2.Validate(a.WebhookAddress)
+		if err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid WebhookAddress.", err)
+		}
+		if _, err = template.New("body").Parse(a.BodyTemplate); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid WebhookAddress, failed to parse the bodyTemplate.", err)
+		}
 		break
 	case v2.EMAIL:
 		err = v2.Validate(a.EmailAddress)
@@ -70,12 +90,17 @@ func NewRESTAddress(host string, port int, httpMethod string) Address {
 
 type MQTTPubAddress struct {
 	Publisher      string `json:"publisher,omitempty" validate:"required"`
-	Topic          string `json:"topic,omitempty" validate:"required"`
-	QoS            int    `json:"qos,omitempty"`
+	Topic          string `json:"topic,omitempty" validate:"required,edgex-dto-mqtt-publish-topic"`
+	QoS            int    `json:"qos,omitempty" validate:"min=0,max=2"`
 	KeepAlive      int    `json:"keepAlive,omitempty"`
 	Retained       bool   `json:"retained,omitempty"`
 	AutoReconnect  bool   `json:"autoReconnect,omitempty"`
 	ConnectTimeout int    `json:"connectTimeout,omitempty"`
+	// AuthMode indicates how to authenticate against the broker, and SecretPath refers to the secret store entry
+	// holding the credentials or certificates, so that no secret is carried in the address itself.
+	AuthMode       string `json:"authMode,omitempty" validate:"omitempty,oneof='none' 'usernamepassword' 'clientcert' 'cacert'"`
+	SecretPath     string `json:"secretPath,omitempty"`
+	SkipCertVerify bool   `json:"skipCertVerify,omitempty"`
 }
 
 func NewMQTTAddress(host string, port int, publisher string, topic string) Address {
@@ -90,6 +115,37 @@ func NewMQTTAddress(host string, port int, publisher string, topic string) Addre
 	}
 }
 
+// WebhookAddress extends the RESTAddress, whose Path and HTTPMethod are shared, with the properties
+// required by third-party webhooks.
+type WebhookAddress struct {
+	// Scheme is either http or https, and defaults to http when omitted
+	Scheme string `json:"scheme,omitempty" validate:"omitempty,oneof='http' 'https'"`
+	// Headers are added to each request as they are
+	Headers map[string]string `json:"headers,omitempty" validate:"omitempty,dive,keys,required,edgex-dto-http-header-name,endkeys"`
+	// SecretName refers to the secret store entry whose key-value pairs are added to each request as headers,
+	// such as Authorization: Bearer <token>, so that no credential is carried in the address itself.
+	SecretName string `json:"secretName,omitempty"`
+	// BodyTemplate is a Go text/template used to render the request body from the notification
+	BodyTemplate string `json:"bodyTemplate,omitempty"`
+	// ExpectedStatusCodes lists the response status codes considered a success, defaults to any 2xx
+	ExpectedStatusCodes []int `json:"expectedStatusCodes,omitempty" validate:"omitempty,dive,min=100,max=599"`
+}
+
+func NewWebhookAddress(scheme string, host string, port int, path string, httpMethod string) Address {
+	return Address{
+		Type: v2.WEBHOOK,
+		Host: host,
+		Port: port,
+		RESTAddress: RESTAddress{
+			Path:       path,
+			HTTPMethod: httpMethod,
+		},
+		WebhookAddress: WebhookAddress{
+			Scheme: scheme,
+		},
+	}
+}
+
 type EmailAddress struct {
 	Recipients []string `json:"recipients,omitempty" validate:"gt=0,dive,email"`
 }
@@ -128,6 +184,23 @@ func ToAddressModel(a Address) models.Address {
 			Retained:       a.Retained,
 			AutoReconnect:  a.AutoReconnect,
 			ConnectTimeout: a.ConnectTimeout,
+			AuthMode:       a.AuthMode,
+			SecretPath:     a.SecretPath,
+			SkipCertVerify: a.SkipCertVerify,
+		}
+		break
+	case v2.WEBHOOK:
+		address = models.WebhookAddress{
+			BaseAddress: models.BaseAddress{
+				Type: a.Type, Host: a.Host, Port: a.Port,
+			},
+			Path:                a.RESTAddress.Path,
+			HTTPMethod:          a.RESTAddress.HTTPMethod,
+			Scheme:              a.WebhookAddress.Scheme,
+			Headers:             a.WebhookAddress.Headers,
+			SecretName:          a.WebhookAddress.SecretName,
+			BodyTemplate:        a.WebhookAddress.BodyTemplate,
+			ExpectedStatusCodes: a.WebhookAddress.ExpectedStatusCodes,
 		}
 		break
 	case v2.EMAIL:
@@ -142,6 +215,9 @@ func ToAddressModel(a Address) models.Address {
 }
 
 func FromAddressModelToDTO(address models.Address) Address {
+	if address == nil {
+		return Address{}
+	}
 	dto := Address{
 		Type: address.GetBaseAddress().Type,
 		Host: address.GetBaseAddress().Host,
@@ -164,6 +240,22 @@ func FromAddressModelToDTO(address models.Address) Address {
 			Retained:       a.Retained,
 			AutoReconnect:  a.AutoReconnect,
 			ConnectTimeout: a.ConnectTimeout,
+			AuthMode:       a.AuthMode,
+			SecretPath:     a.SecretPath,
+			SkipCertVerify: a.SkipCertVerify,
+		}
+		break
+	case models.WebhookAddress:
+		dto.RESTAddress = RESTAddress{
+			Path:       a.Path,
+			HTTPMethod: a.HTTPMethod,
+		}
+		dto.WebhookAddress = WebhookAddress{
+			Scheme:              a.Scheme,
+			Headers:             a.Headers,
+			SecretName:          a.SecretName,
+			BodyTemplate:        a.BodyTemplate,
+			ExpectedStatusCodes: a.ExpectedStatusCodes,
 		}
 		break
 	case models.EmailAddress:
@@ -8,6 +8,7 @@ package dtos
 import (
 	"encoding/json"
 	"fmt"
+	"net/http"
 	"testing"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
@@ -28,6 +29,8 @@ const (
 	testPublisher       = "testPublisher"
 	testTopic           = "testTopic"
 	testEmail           = "test@example.com"
+	testSecretPath      = "mqtt"
+	testSecretName      = "webhook"
 )
 
 var testRESTAddress = Address{
@@ -50,6 +53,23 @@ var testMQTTPubAddress = Address{
 	},
 }
 
+var testWebhookAddress = Address{
+	Type: v2.WEBHOOK,
+	Host: testHost,
+	Port: testPort,
+	RESTAddress: RESTAddress{
+		Path:       testPath,
+		HTTPMethod: http.MethodPost,
+	},
+	WebhookAddress: WebhookAddress{
+		Scheme:              v2.SchemeHTTPS,
+		Headers:             map[string]string{"X-Api-Version": "2"},
+		SecretName:          testSecretName,
+		BodyTemplate:        `{"text":"{{.Content}}"}`,
+		ExpectedStatusCodes: []int{http.StatusOK, http.StatusAccepted},
+	},
+}
+
 var testEmailAddress = Address{
 	Type: v2.EMAIL,
 	EmailAddress: EmailAddress{
@@ -69,6 +89,11 @@ func TestAddress_UnmarshalJSON(t *testing.T) {
 		testMQTTPubAddress.Publisher, testMQTTPubAddress.Topic,
 	)
 	emailJsonStr := fmt.Sprintf(`{"type":"%s","Recipients":["%s"]}`, testEmailAddress.Type, testEmail)
+	webhookJsonStr := fmt.Sprintf(
+		`{"type":"%s","host":"%s","port":%d,"path":"%s","httpMethod":"%s","scheme":"%s","headers":{"X-Api-Version":"2"},"secretName":"%s","bodyTemplate":"{\"text\":\"{{.Content}}\"}","expectedStatusCodes":[200,202]}`,
+		testWebhookAddress.Type, testWebhookAddress.Host, testWebhookAddress.Port, testWebhookAddress.Path,
+		testWebhookAddress.HTTPMethod, testWebhookAddress.Scheme, testWebhookAddress.SecretName,
+	)
 
 	tests := []struct {
 		name     string
@@ -79,6 +104,7 @@ func TestAddress_UnmarshalJSON(t *testing.T) {
 		{"unmarshal RESTAddress with success", testRESTAddress, []byte(restJsonStr), false},
 		{"unmarshal MQTTPubAddress with success", testMQTTPubAddress, []byte(mqttJsonStr), false},
 		{"unmarshal EmailAddress with success", testEmailAddress, []byte(emailJsonStr), false},
+		{"unmarshal WebhookAddress with success", testWebhookAddress, []byte(webhookJsonStr), false},
 		{"unmarshal invalid Address, empty data", Address{}, []byte{}, true},
 		{"unmarshal invalid Address, string data", Address{}, []byte("Invalid address"), true},
 	}
@@ -107,6 +133,42 @@ func TestAddress_Validate(t *testing.T) {
 	noMQTTPublisher.Publisher = ""
 	noMQTTTopic := testMQTTPubAddress
 	noMQTTTopic.Topic = ""
+	mqttTopicWithMultiLevelWildcard := testMQTTPubAddress
+	mqttTopicWithMultiLevelWildcard.Topic = "test/#"
+	mqttTopicWithSingleLevelWildcard := testMQTTPubAddress
+	mqttTopicWithSingleLevelWildcard.Topic = "test/+/topic"
+	validMQTTQoS := testMQTTPubAddress
+	validMQTTQoS.QoS = 2
+	invalidMQTTQoS := testMQTTPubAddress
+	invalidMQTTQoS.QoS = 3
+	negativeMQTTQoS := testMQTTPubAddress
+	negativeMQTTQoS.QoS = -1
+	validMQTTAuth := testMQTTPubAddress
+	validMQTTAuth.AuthMode = v2.AuthModeUsernamePassword
+	validMQTTAuth.SecretPath = testSecretPath
+	validMQTTNoneAuth := testMQTTPubAddress
+	validMQTTNoneAuth.AuthMode = v2.AuthModeNone
+	noMQTTSecretPath := testMQTTPubAddress
+	noMQTTSecretPath.AuthMode = v2.AuthModeCert
+	invalidMQTTAuthMode := testMQTTPubAddress
+	invalidMQTTAuthMode.AuthMode = "invalid"
+	invalidMQTTAuthMode.SecretPath = testSecretPath
+
+	validWebhook := testWebhookAddress
+	noWebhookHttpMethod := testWebhookAddress
+	noWebhookHttpMethod.HTTPMethod = ""
+	validWebhookNoScheme := testWebhookAddress
+	validWebhookNoScheme.Scheme = ""
+	invalidWebhookScheme := testWebhookAddress
+	invalidWebhookScheme.Scheme = "ftp"
+	invalidWebhookHeaderName := testWebhookAddress
+	invalidWebhookHeaderName.Headers = map[string]string{"X Api Version": "2"}
+	emptyWebhookHeaderName := testWebhookAddress
+	emptyWebhookHeaderName.Headers = map[string]string{"": "2"}
+	invalidWebhookBodyTemplate := testWebhookAddress
+	invalidWebhookBodyTemplate.BodyTemplate = `{"text":"{{.Content"}`
+	invalidWebhookStatusCode := testWebhookAddress
+	invalidWebhookStatusCode.ExpectedStatusCodes = []int{http.StatusOK, 600}
 
 	validEmail := testEmailAddress
 	invalidEmailAddress := testEmailAddress
@@ -122,6 +184,23 @@ func TestAddress_Validate(t *testing.T) {
 		{"valid MQTTPubAddress", validMQTT, false},
 		{"invalid MQTTPubAddress, no MQTT publisher", noMQTTPublisher, true},
 		{"invalid MQTTPubAddress, no MQTT Topic", noMQTTTopic, true},
+		{"invalid MQTTPubAddress, topic with multi-level wildcard", mqttTopicWithMultiLevelWildcard, true},
+		{"invalid MQTTPubAddress, topic with single-level wildcard", mqttTopicWithSingleLevelWildcard, true},
+		{"valid MQTTPubAddress, QoS 2", validMQTTQoS, false},
+		{"invalid MQTTPubAddress, QoS greater than 2", invalidMQTTQoS, true},
+		{"invalid MQTTPubAddress, negative QoS", negativeMQTTQoS, true},
+		{"valid MQTTPubAddress, with authMode and secretPath", validMQTTAuth, false},
+		{"valid MQTTPubAddress, none authMode without secretPath", validMQTTNoneAuth, false},
+		{"invalid MQTTPubAddress, authMode without secretPath", noMQTTSecretPath, true},
+		{"invalid MQTTPubAddress, unsupported authMode", invalidMQTTAuthMode, true},
+		{"valid WebhookAddress", validWebhook, false},
+		{"invalid WebhookAddress, no HTTP method", noWebhookHttpMethod, true},
+		{"valid WebhookAddress, no scheme", validWebhookNoScheme, false},
+		{"invalid WebhookAddress, unsupported scheme", invalidWebhookScheme, true},
+		{"invalid WebhookAddress, invalid header name", invalidWebhookHeaderName, true},
+		{"invalid WebhookAddress, empty header name", emptyWebhookHeaderName, true},
+		{"invalid WebhookAddress, invalid body template", invalidWebhookBodyTemplate, true},
+		{"invalid WebhookAddress, invalid expected status code", invalidWebhookStatusCode, true},
 		{"valid EmailAddress", validEmail, false},
 		{"invalid EmailAddress", invalidEmailAddress, true},
 	}
@@ -137,6 +216,36 @@ func TestAddress_Validate(t *testing.T) {
 	}
 }
 
+func TestMQTTPubAddressModelAndDTOConversion(t *testing.T) {
+	dto := testMQTTPubAddress
+	dto.QoS = 1
+	dto.AuthMode = v2.AuthModeCA
+	dto.SecretPath = testSecretPath
+	dto.SkipCertVerify = true
+
+	m := ToAddressModel(dto)
+	require.IsType(t, models.MQTTPubAddress{}, m)
+	mqtt := m.(models.MQTTPubAddress)
+	assert.Equal(t, dto.AuthMode, mqtt.AuthMode)
+	assert.Equal(t, dto.SecretPath, mqtt.SecretPath)
+	assert.Equal(t, dto.SkipCertVerify, mqtt.SkipCertVerify)
+	assert.Equal(t, dto, FromAddressModelToDTO(m))
+}
+
+func TestWebhookAddressModelAndDTOConversion(t *testing.T) {
+	m := ToAddressModel(testWebhookAddress)
+	require.IsType(t, models.WebhookAddress{}, m)
+	webhook := m.(models.WebhookAddress)
+	assert.Equal(t, testWebhookAddress.Path, webhook.Path)
+	assert.Equal(t, testWebhookAddress.HTTPMethod, webhook.HTTPMethod)
+	assert.Equal(t, testWebhookAddress.Scheme, webhook.Scheme)
+	assert.Equal(t, testWebhookAddress.Headers, webhook.Headers)
+	assert.Equal(t, testWebhookAddress.SecretName, webhook.SecretName)
+	assert.Equal(t, testWebhookAddress.BodyTemplate, webhook.BodyTemplate)
+	assert.Equal(t, testWebhookAddress.ExpectedStatusCodes, webhook.ExpectedStatusCodes)
+	assert.Equal(t, testWebhookAddress, FromAddressModelToDTO(m))
+}
+
 func TestEmailAddressModelToDTO(t *testing.T) {
 	recipients := []string{"test@example.com"}
 	m := models.EmailAddress{Recipients: recipients}
@@ -131,3 +131,22 @@ func FromDeviceModelToUpdateDTO(d models.Device) UpdateDevice {
 	}
 	return dto
 }
+
+// UpdateTo returns the minimal UpdateDevice which updates the Device to its newer version, or nil if both versions
+// are the same. The UpdateDevice selects the Device by the Name of the older version. The returned field mask lists
+// the JSON names of the fields to clear which the UpdateDevice can't express, and is the FieldMask of its request.
+func (d Device) UpdateTo(newer Device) (*UpdateDevice, []string) {
+	var update UpdateDevice
+	mask, changed := updateFields(d, newer, &update)
+	if !changed {
+		return nil, nil
+	}
+	update.Name = &d.Name
+	return &update, mask
+}
+
+// MarshalJSON implements the json.Marshaler interface to omit the nil fields but keep the empty slices and maps, as
+// an empty slice or map clears the field of the Device while an absent one leaves it unchanged
+func (d UpdateDevice) MarshalJSON() ([]byte, error) {
+	return marshalUpdate(d)
+}
@@ -6,11 +6,13 @@
 package dtos
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestFromDeviceModelToUpdateDTO(t *testing.T) {
@@ -29,3 +31,27 @@ func TestFromDeviceModelToUpdateDTO(t *testing.T) {
 	assert.Nil(t, dto.AutoEvents)
 	assert.Nil(t, dto.Protocols)
 }
+
+func TestUpdateDevice_MarshalJSON(t *testing.T) {
+	description := "description"
+	tests := []struct {
+		name     string
+		dto      UpdateDevice
+		expected string
+	}{
+		{"absent fields omitted", UpdateDevice{Description: &description}, `{"description":"description"}`},
+		{"empty slice kept", UpdateDevice{Labels: []string{}}, `{"labels":[]}`},
+		{"empty map kept", UpdateDevice{Protocols: map[string]ProtocolProperties{}}, `{"protocols":{}}`},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			data, err := json.Marshal(testCase.dto)
+			require.NoError(t, err)
+			assert.JSONEq(t, testCase.expected, string(data))
+
+			var decoded UpdateDevice
+			require.NoError(t, json.Unmarshal(data, &decoded))
+			assert.Equal(t, testCase.dto, decoded)
+		})
+	}
+}
@@ -0,0 +1,293 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Constants for the ChangeType of a ProfileChange
+const (
+	ChangeAdded    ChangeType = "Added"
+	ChangeRemoved  ChangeType = "Removed"
+	ChangeModified ChangeType = "Modified"
+)
+
+// ChangeType tells whether an element of the profile is added, removed or modified
+type ChangeType string
+
+// ProfileChange is a single change between two versions of a DeviceProfile
+type ProfileChange struct {
+	Type ChangeType
+	// Path identifies the changed element, e.g. deviceResources[temperature].properties.valueType
+	Path string
+	// Source is the name of the changed device resource or device command, empty for the profile fields
+	Source string
+	Old    string
+	New    string
+	// Breaking is true if the change may break the devices, the AutoEvents or the clients using the profile, e.g. a
+	// removed device resource or a changed ValueType
+	Breaking bool
+}
+
+// String returns the readable description of the ProfileChange
+func (c ProfileChange) String() string {
+	breaking := ""
+	if c.Breaking {
+		breaking = " (breaking)"
+	}
+	switch c.Type {
+	case ChangeAdded:
+		return fmt.Sprintf("added %s%s", c.Path, breaking)
+	case ChangeRemoved:
+		return fmt.Sprintf("removed %s%s", c.Path, breaking)
+	default:
+		return fmt.Sprintf("modified %s from %s to %s%s", c.Path, c.Old, c.New, breaking)
+	}
+}
+
+// ProfileDiff lists the changes between two versions of a DeviceProfile
+type ProfileDiff struct {
+	ProfileName string
+	Changes     []ProfileChange
+	// CommandResources maps the device commands of both versions to their device resources, so that the impact of the
+	// changes of the device resources on the device commands is known after the ProfileDiff is decoded
+	CommandResources map[string][]string
+}
+
+// IsEmpty returns true if the two versions are the same
+func (d ProfileDiff) IsEmpty() bool {
+	return len(d.Changes) == 0
+}
+
+// HasBreakingChanges returns true if any change is breaking
+func (d ProfileDiff) HasBreakingChanges() bool {
+	for _, c := range d.Changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+// DeviceImpact describes how a device using the profile is affected by a ProfileDiff
+type DeviceImpact struct {
+	DeviceName string
+	// AutoEvents are the AutoEvents of the device whose source is changed, either directly or through the device
+	// resources of the device command
+	AutoEvents []AutoEvent
+	// Breaking is true if a breaking change hits the device, i.e. the change of a profile field such as the name, or of
+	// the source of any AutoEvent of the device
+	Breaking bool
+}
+
+// Diff compares the DeviceProfile with its newer version. The ProfileDiff is sorted by the path of the changes.
+func (dp DeviceProfile) Diff(newer DeviceProfile) ProfileDiff {
+	var c changes
+	c.compare("name", "", dp.Name, newer.Name, true)
+	c.compare("manufacturer", "", dp.Manufacturer, newer.Manufacturer, false)
+	c.compare("model", "", dp.Model, newer.Model, false)
+	c.compare("description", "", dp.Description, newer.Description, false)
+	c.compare("labels", "", dp.Labels, newer.Labels, false)
+
+	oldResources := make(map[string]DeviceResource, len(dp.DeviceResources))
+	for _, r := range dp.DeviceResources {
+		oldResources[r.Name] = r
+	}
+	newResources := make(map[string]DeviceResource, len(newer.DeviceResources))
+	for _, r := range newer.DeviceResources {
+		newResources[r.Name] = r
+		old, ok := oldResources[r.Name]
+		if !ok {
+			c.add(ProfileChange{Type: ChangeAdded, Path: resourcePath(r.Name), Source: r.Name})
+			continue
+		}
+		c.compareResources(old, r)
+	}
+	for _, r := range dp.DeviceResources {
+		if _, ok := newResources[r.Name]; !ok {
+			c.add(ProfileChange{Type: ChangeRemoved, Path: resourcePath(r.Name), Source: r.Name, Breaking: true})
+		}
+	}
+
+	oldCommands := make(map[string]DeviceCommand, len(dp.DeviceCommands))
+	for _, cmd := range dp.DeviceCommands {
+		oldCommands[cmd.Name] = cmd
+	}
+	newCommands := make(map[string]bool, len(newer.DeviceCommands))
+	for _, cmd := range newer.DeviceCommands {
+		newCommands[cmd.Name] = true
+		old, ok := oldCommands[cmd.Name]
+		if !ok {
+			c.add(ProfileChange{Type: ChangeAdded, Path: commandPath(cmd.Name), Source: cmd.Name})
+			continue
+		}
+		c.compareCommands(old, cmd)
+	}
+	for _, cmd := range dp.DeviceCommands {
+		if !newCommands[cmd.Name] {
+			c.add(ProfileChange{Type: ChangeRemoved, Path: commandPath(cmd.Name), Source: cmd.Name, Breaking: true})
+		}
+	}
+
+	commandResources := make(map[string][]string)
+	for _, commands := range [][]DeviceCommand{dp.DeviceCommands, newer.DeviceCommands} {
+		for _, cmd := range commands {
+			commandResources[cmd.Name] = append(commandResources[cmd.Name], operationResources(cmd.ResourceOperations)...)
+		}
+	}
+	sort.SliceStable(c, func(i, j int) bool { return c[i].Path < c[j].Path })
+	return ProfileDiff{ProfileName: dp.Name, Changes: c, CommandResources: commandResources}
+}
+
+// Impact lists the devices using the profile, i.e. whose ProfileName is the name of the older version, and their
+// AutoEvents affected by the changes. The devices are listed in the given order, and none is listed if the diff is
+// empty.
+func (d ProfileDiff) Impact(devices []Device) []DeviceImpact {
+	if d.IsEmpty() {
+		return nil
+	}
+	changed := d.changedSources(false)
+	breaking := d.changedSources(true)
+	var impacts []DeviceImpact
+	for _, device := range devices {
+		if device.ProfileName != d.ProfileName {
+			continue
+		}
+		// The breaking changes of the profile fields, whose source is empty, hit all the devices
+		impact := DeviceImpact{DeviceName: device.Name, Breaking: breaking[""]}
+		for _, ae := range device.AutoEvents {
+			if changed[ae.SourceName] {
+				impact.AutoEvents = append(impact.AutoEvents, ae)
+			}
+			if breaking[ae.SourceName] {
+				impact.Breaking = true
+			}
+		}
+		impacts = append(impacts, impact)
+	}
+	return impacts
+}
+
+// changedSources returns the names of the changed device resources and device commands, or only the ones with the
+// breaking changes, where a device command is also changed if any of its device resources is changed. The empty name
+// stands for the profile fields.
+func (d ProfileDiff) changedSources(onlyBreaking bool) map[string]bool {
+	changed := make(map[string]bool)
+	for _, c := range d.Changes {
+		if c.Breaking || !onlyBreaking {
+			changed[c.Source] = true
+		}
+	}
+	for command, resources := range d.CommandResources {
+		for _, r := range resources {
+			if changed[r] {
+				changed[command] = true
+				break
+			}
+		}
+	}
+	return changed
+}
+
+type changes []ProfileChange
+
+func (c *changes) add(change ProfileChange) {
+	*c = append(*c, change)
+}
+
+// compare adds a ProfileChange if the old and new values are different
+func (c *changes) compare(path string, source string, old interface{}, new interface{}, breaking bool) {
+	if reflect.DeepEqual(old, new) || (isEmptyValue(old) && isEmptyValue(new)) {
+		return
+	}
+	c.add(ProfileChange{
+		Type:     ChangeModified,
+		Path:     path,
+		Source:   source,
+		Old:      fmt.Sprintf("%v", old),
+		New:      fmt.Sprintf("%v", new),
+		Breaking: breaking,
+	})
+}
+
+func (c *changes) compareResources(old DeviceResource, new DeviceResource) {
+	path := resourcePath(old.Name)
+	c.compare(path+".description", old.Name, old.Description, new.Description, false)
+	c.compare(path+".tag", old.Name, old.Tag, new.Tag, false)
+	c.compare(path+".attributes", old.Name, old.Attributes, new.Attributes, false)
+	// A hidden resource isn't available through the core commands anymore
+	c.compare(path+".isHidden", old.Name, old.IsHidden, new.IsHidden, new.IsHidden)
+
+	op, np := old.Properties, new.Properties
+	path += ".properties"
+	c.compare(path+".valueType", old.Name, op.ValueType, np.ValueType, true)
+	c.compare(path+".readWrite", old.Name, op.ReadWrite, np.ReadWrite, narrowsReadWrite(op.ReadWrite, np.ReadWrite))
+	c.compare(path+".units", old.Name, op.Units, np.Units, false)
+	c.compare(path+".minimum", old.Name, op.Minimum, np.Minimum, false)
+	c.compare(path+".maximum", old.Name, op.Maximum, np.Maximum, false)
+	c.compare(path+".defaultValue", old.Name, op.DefaultValue, np.DefaultValue, false)
+	c.compare(path+".mask", old.Name, op.Mask, np.Mask, false)
+	c.compare(path+".shift", old.Name, op.Shift, np.Shift, false)
+	c.compare(path+".scale", old.Name, op.Scale, np.Scale, false)
+	c.compare(path+".offset", old.Name, op.Offset, np.Offset, false)
+	c.compare(path+".base", old.Name, op.Base, np.Base, false)
+	c.compare(path+".assertion", old.Name, op.Assertion, np.Assertion, false)
+	c.compare(path+".mediaType", old.Name, op.MediaType, np.MediaType, false)
+}
+
+func (c *changes) compareCommands(old DeviceCommand, new DeviceCommand) {
+	path := commandPath(old.Name)
+	c.compare(path+".isHidden", old.Name, old.IsHidden, new.IsHidden, new.IsHidden)
+	c.compare(path+".readWrite", old.Name, old.ReadWrite, new.ReadWrite, narrowsReadWrite(old.ReadWrite, new.ReadWrite))
+	// The readings of the command change if its device resources change, while the default values and the mappings
+	// only change the values
+	c.compare(path+".resourceOperations", old.Name, operationResources(old.ResourceOperations), operationResources(new.ResourceOperations), true)
+	for i, ro := range old.ResourceOperations {
+		if i >= len(new.ResourceOperations) || new.ResourceOperations[i].DeviceResource != ro.DeviceResource {
+			break
+		}
+		nro := new.ResourceOperations[i]
+		roPath := fmt.Sprintf("%s.resourceOperations[%s]", path, ro.DeviceResource)
+		c.compare(roPath+".defaultValue", old.Name, ro.DefaultValue, nro.DefaultValue, false)
+		c.compare(roPath+".mappings", old.Name, ro.Mappings, nro.Mappings, false)
+	}
+}
+
+func operationResources(operations []ResourceOperation) []string {
+	names := make([]string, len(operations))
+	for i, ro := range operations {
+		names[i] = ro.DeviceResource
+	}
+	return names
+}
+
+// narrowsReadWrite returns true if the new permission drops the read or write permission of the old one
+func narrowsReadWrite(old string, new string) bool {
+	old, new = strings.ToUpper(old), strings.ToUpper(new)
+	return (strings.Contains(old, "R") && !strings.Contains(new, "R")) || (strings.Contains(old, "W") && !strings.Contains(new, "W"))
+}
+
+func isEmptyValue(v interface{}) bool {
+	rv := reflect.ValueOf(v)
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Map:
+		return rv.Len() == 0
+	default:
+		return false
+	}
+}
+
+func resourcePath(name string) string {
+	return fmt.Sprintf("deviceResources[%s]", name)
+}
+
+func commandPath(name string) string {
+	return fmt.Sprintf("deviceCommands[%s]", name)
+}
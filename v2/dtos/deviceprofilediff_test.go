@@ -0,0 +1,152 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+)
+
+func diffTestProfile() DeviceProfile {
+	return DeviceProfile{
+		Name:         "profile",
+		Manufacturer: "IOTech",
+		Labels:       []string{"sensor"},
+		DeviceResources: []DeviceResource{
+			{Name: "temperature", Properties: ResourceProperties{ValueType: v2.ValueTypeInt16, ReadWrite: "RW", Units: "C"}},
+			{Name: "humidity", Properties: ResourceProperties{ValueType: v2.ValueTypeInt16, ReadWrite: "R"}},
+			{Name: "switch", Properties: ResourceProperties{ValueType: v2.ValueTypeBool, ReadWrite: "RW"}},
+		},
+		DeviceCommands: []DeviceCommand{
+			{Name: "climate", ReadWrite: "R", ResourceOperations: []ResourceOperation{{DeviceResource: "temperature"}, {DeviceResource: "humidity"}}},
+			{Name: "control", ReadWrite: "RW", ResourceOperations: []ResourceOperation{{DeviceResource: "switch", DefaultValue: "false"}}},
+		},
+	}
+}
+
+func TestDeviceProfile_Diff(t *testing.T) {
+	newer := diffTestProfile()
+	newer.Manufacturer = "IOTech Ltd"
+	newer.DeviceResources[0].Properties.ValueType = v2.ValueTypeFloat32
+	newer.DeviceResources[0].Properties.ReadWrite = "R"
+	newer.DeviceResources[1].Properties.ReadWrite = "RW"
+	newer.DeviceResources = append(newer.DeviceResources[:2], DeviceResource{Name: "pressure", Properties: ResourceProperties{ValueType: v2.ValueTypeFloat32, ReadWrite: "R"}})
+	newer.DeviceCommands[1].ResourceOperations[0].DefaultValue = "true"
+	newer.DeviceCommands = append(newer.DeviceCommands, DeviceCommand{Name: "all", ReadWrite: "R", ResourceOperations: []ResourceOperation{{DeviceResource: "pressure"}}})
+
+	diff := diffTestProfile().Diff(newer)
+	assert.Equal(t, "profile", diff.ProfileName)
+	assert.True(t, diff.HasBreakingChanges())
+	assert.Equal(t, []ProfileChange{
+		{Type: ChangeAdded, Path: "deviceCommands[all]", Source: "all"},
+		{Type: ChangeModified, Path: "deviceCommands[control].resourceOperations[switch].defaultValue", Source: "control", Old: "false", New: "true"},
+		{Type: ChangeModified, Path: "deviceResources[humidity].properties.readWrite", Source: "humidity", Old: "R", New: "RW"},
+		{Type: ChangeAdded, Path: "deviceResources[pressure]", Source: "pressure"},
+		{Type: ChangeRemoved, Path: "deviceResources[switch]", Source: "switch", Breaking: true},
+		{Type: ChangeModified, Path: "deviceResources[temperature].properties.readWrite", Source: "temperature", Old: "RW", New: "R", Breaking: true},
+		{Type: ChangeModified, Path: "deviceResources[temperature].properties.valueType", Source: "temperature", Old: "Int16", New: "Float32", Breaking: true},
+		{Type: ChangeModified, Path: "manufacturer", Old: "IOTech", New: "IOTech Ltd"},
+	}, diff.Changes)
+	assert.Equal(t, "modified deviceResources[temperature].properties.valueType from Int16 to Float32 (breaking)", diff.Changes[6].String())
+}
+
+func TestDeviceProfile_Diff_Classification(t *testing.T) {
+	tests := []struct {
+		name             string
+		update           func(p *DeviceProfile)
+		expectedPath     string
+		expectedBreaking bool
+	}{
+		{"rename", func(p *DeviceProfile) { p.Name = "renamed" }, "name", true},
+		{"labels", func(p *DeviceProfile) { p.Labels = append(p.Labels, "new") }, "labels", false},
+		{"units", func(p *DeviceProfile) { p.DeviceResources[0].Properties.Units = "F" }, "deviceResources[temperature].properties.units", false},
+		{"hide resource", func(p *DeviceProfile) { p.DeviceResources[0].IsHidden = true }, "deviceResources[temperature].isHidden", true},
+		{"attributes", func(p *DeviceProfile) { p.DeviceResources[0].Attributes = map[string]interface{}{"register": 1} }, "deviceResources[temperature].attributes", false},
+		{"remove command", func(p *DeviceProfile) { p.DeviceCommands = p.DeviceCommands[:1] }, "deviceCommands[control]", true},
+		{"command resources", func(p *DeviceProfile) {
+			p.DeviceCommands[0].ResourceOperations = p.DeviceCommands[0].ResourceOperations[:1]
+		}, "deviceCommands[climate].resourceOperations", true},
+		{"command read only", func(p *DeviceProfile) { p.DeviceCommands[1].ReadWrite = "R" }, "deviceCommands[control].readWrite", true},
+		{"command mappings", func(p *DeviceProfile) {
+			p.DeviceCommands[1].ResourceOperations[0].Mappings = map[string]string{"true": "on"}
+		}, "deviceCommands[control].resourceOperations[switch].mappings", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			newer := diffTestProfile()
+			tt.update(&newer)
+			diff := diffTestProfile().Diff(newer)
+			require.Len(t, diff.Changes, 1)
+			assert.Equal(t, tt.expectedPath, diff.Changes[0].Path)
+			assert.Equal(t, tt.expectedBreaking, diff.Changes[0].Breaking)
+		})
+	}
+}
+
+func TestDeviceProfile_Diff_Unchanged(t *testing.T) {
+	newer := diffTestProfile()
+	newer.Labels = []string{"sensor"}
+	newer.DeviceCommands[0].ResourceOperations[0].Mappings = map[string]string{}
+	diff := diffTestProfile().Diff(newer)
+	assert.True(t, diff.IsEmpty())
+	assert.False(t, diff.HasBreakingChanges())
+	assert.Nil(t, diff.Impact([]Device{{Name: "device", ProfileName: "profile"}}))
+}
+
+func TestProfileDiff_Impact(t *testing.T) {
+	newer := diffTestProfile()
+	newer.DeviceResources[1].Properties.ValueType = v2.ValueTypeFloat32
+	diff := diffTestProfile().Diff(newer)
+
+	temperature := AutoEvent{Interval: "10s", SourceName: "temperature"}
+	humidity := AutoEvent{Interval: "10s", SourceName: "humidity"}
+	climate := AutoEvent{Interval: "1m", SourceName: "climate"}
+	devices := []Device{
+		{Name: "device1", ProfileName: "profile", AutoEvents: []AutoEvent{temperature, humidity, climate}},
+		{Name: "device2", ProfileName: "other"},
+		{Name: "device3", ProfileName: "profile"},
+	}
+	assert.Equal(t, []DeviceImpact{
+		{DeviceName: "device1", AutoEvents: []AutoEvent{humidity, climate}, Breaking: true},
+		{DeviceName: "device3", Breaking: false},
+	}, diff.Impact(devices))
+
+	// The non-breaking change only lists the affected AutoEvents
+	newer = diffTestProfile()
+	newer.DeviceResources[0].Properties.Units = "F"
+	diff = diffTestProfile().Diff(newer)
+	assert.Equal(t, []DeviceImpact{
+		{DeviceName: "device1", AutoEvents: []AutoEvent{temperature, climate}},
+		{DeviceName: "device3"},
+	}, diff.Impact(devices))
+
+	// The breaking change of a profile field hits all the devices
+	newer.Name = "renamed"
+	diff = diffTestProfile().Diff(newer)
+	assert.Equal(t, []DeviceImpact{
+		{DeviceName: "device1", AutoEvents: []AutoEvent{temperature, climate}, Breaking: true},
+		{DeviceName: "device3", Breaking: true},
+	}, diff.Impact(devices))
+}
+
+func TestProfileDiff_Impact_JSON(t *testing.T) {
+	newer := diffTestProfile()
+	newer.DeviceResources[0].Properties.ValueType = v2.ValueTypeFloat32
+	data, err := json.Marshal(diffTestProfile().Diff(newer))
+	require.NoError(t, err)
+	var decoded ProfileDiff
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	climate := AutoEvent{Interval: "1m", SourceName: "climate"}
+	devices := []Device{{Name: "device1", ProfileName: "profile", AutoEvents: []AutoEvent{climate}}}
+	assert.Equal(t, []DeviceImpact{{DeviceName: "device1", AutoEvents: []AutoEvent{climate}, Breaking: true}}, decoded.Impact(devices),
+		"the device command should be affected by the change of its device resource after decoding")
+}
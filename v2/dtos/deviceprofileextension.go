@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+)
+
+// DeviceProfileExtension is a device profile which extends the base profile named by Extends. The DeviceResources
+// and DeviceCommands override the ones of the base profile with the same name or are added to them, and the
+// RemovedDeviceResources and RemovedDeviceCommands are removed from them. The non-empty Manufacturer, Description,
+// Model and Labels override the ones of the base profile.
+type DeviceProfileExtension struct {
+	Name                   string           `json:"name" yaml:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Extends                string           `json:"extends" yaml:"extends" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Manufacturer           string           `json:"manufacturer,omitempty" yaml:"manufacturer,omitempty"`
+	Description            string           `json:"description,omitempty" yaml:"description,omitempty"`
+	Model                  string           `json:"model,omitempty" yaml:"model,omitempty"`
+	Labels                 []string         `json:"labels,omitempty" yaml:"labels,flow,omitempty"`
+	DeviceResources        []DeviceResource `json:"deviceResources,omitempty" yaml:"deviceResources,omitempty" validate:"dive"`
+	DeviceCommands         []DeviceCommand  `json:"deviceCommands,omitempty" yaml:"deviceCommands,omitempty" validate:"dive"`
+	RemovedDeviceResources []string         `json:"removedDeviceResources,omitempty" yaml:"removedDeviceResources,omitempty"`
+	RemovedDeviceCommands  []string         `json:"removedDeviceCommands,omitempty" yaml:"removedDeviceCommands,omitempty"`
+}
+
+// Validate satisfies the Validator interface
+func (e DeviceProfileExtension) Validate() error {
+	err := v2.Validate(e)
+	if err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid DeviceProfileExtension.", err)
+	}
+	return nil
+}
@@ -93,3 +93,22 @@ func FromDeviceServiceModelToUpdateDTO(ds models.DeviceService) UpdateDeviceServ
 	}
 	return dto
 }
+
+// UpdateTo returns the minimal UpdateDeviceService which updates the DeviceService to its newer version, or nil if both versions
+// are the same. The UpdateDeviceService selects the DeviceService by the Name of the older version. The returned field mask lists
+// the JSON names of the fields to clear which the UpdateDeviceService can't express, and is the FieldMask of its request.
+func (ds DeviceService) UpdateTo(newer DeviceService) (*UpdateDeviceService, []string) {
+	var update UpdateDeviceService
+	mask, changed := updateFields(ds, newer, &update)
+	if !changed {
+		return nil, nil
+	}
+	update.Name = &ds.Name
+	return &update, mask
+}
+
+// MarshalJSON implements the json.Marshaler interface to omit the nil fields but keep the empty slices and maps, as
+// an empty slice or map clears the field of the DeviceService while an absent one leaves it unchanged
+func (ds UpdateDeviceService) MarshalJSON() ([]byte, error) {
+	return marshalUpdate(ds)
+}
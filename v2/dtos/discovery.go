@@ -0,0 +1,27 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+// Discovery represents the progress of a device discovery triggered on a device service
+type Discovery struct {
+	Id              string `json:"id" validate:"required,uuid"`
+	ServiceName     string `json:"serviceName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	State           string `json:"state" validate:"oneof='RUNNING' 'COMPLETED' 'FAILED'"`
+	Progress        int    `json:"progress" validate:"min=0,max=100"`
+	Started         int64  `json:"started,omitempty"`
+	Finished        int64  `json:"finished,omitempty"`
+	Message         string `json:"message,omitempty"`
+	DiscoveredCount int    `json:"discoveredCount"`
+}
+
+// DiscoveredDevice represents a device found by the device discovery, which is a candidate to be added if it matches
+// a provision watcher
+type DiscoveredDevice struct {
+	Name        string                        `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
+	Description string                        `json:"description,omitempty"`
+	Labels      []string                      `json:"labels,omitempty"`
+	Protocols   map[string]ProtocolProperties `json:"protocols" validate:"required,gt=0"`
+}
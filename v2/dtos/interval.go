@@ -65,3 +65,16 @@ func FromIntervalModelToDTO(model models.Interval) Interval {
 	dto.RunOnce = model.RunOnce
 	return dto
 }
+
+// UpdateTo returns the minimal UpdateInterval which updates the Interval to its newer version, or nil if both versions
+// are the same. The UpdateInterval selects the Interval by the Name of the older version. The returned field mask lists
+// the JSON names of the fields to clear which the UpdateInterval can't express, and is the FieldMask of its request.
+func (i Interval) UpdateTo(newer Interval) (*UpdateInterval, []string) {
+	var update UpdateInterval
+	mask, changed := updateFields(i, newer, &update)
+	if !changed {
+		return nil, nil
+	}
+	update.Name = &i.Name
+	return &update, mask
+}
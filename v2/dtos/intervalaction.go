@@ -75,3 +75,16 @@ func FromIntervalActionModelToDTO(model models.IntervalAction) IntervalAction {
 	dto.AdminState = string(model.AdminState)
 	return dto
 }
+
+// UpdateTo returns the minimal UpdateIntervalAction which updates the IntervalAction to its newer version, or nil if both versions
+// are the same. The UpdateIntervalAction selects the IntervalAction by the Name of the older version. The returned field mask lists
+// the JSON names of the fields to clear which the UpdateIntervalAction can't express, and is the FieldMask of its request.
+func (ia IntervalAction) UpdateTo(newer IntervalAction) (*UpdateIntervalAction, []string) {
+	var update UpdateIntervalAction
+	mask, changed := updateFields(ia, newer, &update)
+	if !changed {
+		return nil, nil
+	}
+	update.Name = &ia.Name
+	return &update, mask
+}
@@ -6,6 +6,7 @@
 package dtos
 
 import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
 
 	"github.com/google/uuid"
@@ -38,9 +39,11 @@ func NewNotification(labels []string, category, content, sender, severity string
 	}
 }
 
-// ToNotificationModel transforms the Notification DTO to the Notification Model
+// ToNotificationModel transforms the Notification DTO to the Notification Model. The Severity and Status are parsed,
+// while an unknown one, which the validation rejects, is kept as is for the model validation to report.
 func ToNotificationModel(n Notification) models.Notification {
 	var m models.Notification
+	var err errors.EdgeX
 	m.Id = n.Id
 	m.DBTimestamp = models.DBTimestamp(n.DBTimestamp)
 	m.Category = n.Category
@@ -49,8 +52,12 @@ func ToNotificationModel(n Notification) models.Notification {
 	m.ContentType = n.ContentType
 	m.Description = n.Description
 	m.Sender = n.Sender
-	m.Severity = models.NotificationSeverity(n.Severity)
-	m.Status = models.NotificationStatus(n.Status)
+	if m.Severity, err = models.ParseNotificationSeverity(n.Severity); err != nil {
+		m.Severity = models.NotificationSeverity(n.Severity)
+	}
+	if m.Status, err = models.ParseNotificationStatus(n.Status); err != nil {
+		m.Status = models.NotificationStatus(n.Status)
+	}
 	return m
 }
 
@@ -74,8 +81,8 @@ func FromNotificationModelToDTO(n models.Notification) Notification {
 		ContentType: n.ContentType,
 		Description: n.Description,
 		Sender:      n.Sender,
-		Severity:    string(n.Severity),
-		Status:      string(n.Status),
+		Severity:    n.Severity.String(),
+		Status:      n.Status.String(),
 	}
 }
 
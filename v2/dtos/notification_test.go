@@ -3,6 +3,7 @@ package dtos
 import (
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
 
 	"github.com/stretchr/testify/assert"
@@ -29,3 +30,43 @@ func TestNewNotification(t *testing.T) {
 	assert.Zero(t, actual.Created)
 	assert.Zero(t, actual.Modified)
 }
+
+func TestNotification_Validate(t *testing.T) {
+	valid := NewNotification(nil, "category", "content", "sender", models.Minor)
+	validStatus := valid
+	validStatus.Status = models.Processed
+	invalidSeverity := valid
+	invalidSeverity.Severity = "minor"
+	invalidStatus := valid
+	invalidStatus.Status = "foo"
+
+	tests := []struct {
+		name          string
+		notification  Notification
+		expectedError bool
+	}{
+		{"valid", valid, false},
+		{"valid, with status", validStatus, false},
+		{"invalid, unknown severity", invalidSeverity, true},
+		{"invalid, unknown status", invalidStatus, true},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := v2.Validate(testCase.notification)
+			assert.Equal(t, testCase.expectedError, err != nil)
+		})
+	}
+}
+
+func TestToNotificationModel_SeverityAndStatus(t *testing.T) {
+	n := NewNotification(nil, "category", "content", "sender", models.Critical)
+	n.Status = models.Escalated
+	m := ToNotificationModel(n)
+	assert.Equal(t, models.SeverityCritical, m.Severity)
+	assert.Equal(t, models.StatusEscalated, m.Status)
+
+	n.Severity, n.Status = "foo", ""
+	m = ToNotificationModel(n)
+	assert.Error(t, m.Severity.Validate())
+	assert.Empty(t, m.Status)
+}
@@ -0,0 +1,131 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"bytes"
+	"fmt"
+	htmltemplate "html/template"
+	"io"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+
+	"github.com/google/uuid"
+)
+
+// DefaultMaxNotificationContentSize is the default maximum size in bytes of both the content template and the
+// rendered Notification content
+const DefaultMaxNotificationContentSize = 64 * 1024
+
+// templateExecutor is satisfied by both the text/template and html/template Template
+type templateExecutor interface {
+	Execute(wr io.Writer, data interface{}) error
+}
+
+// NotificationTemplate produces the Notification DTO whose Content is rendered from an Event or a Reading by a Go
+// template, e.g. "Device {{.DeviceName}} reading {{.ResourceName}}={{.Value}}".
+// The text/html content is rendered by html/template, which escapes the data according to the HTML context, while
+// any other content type is rendered by text/template as it is.
+type NotificationTemplate struct {
+	// MaxContentSize limits the size in bytes of the rendered content, DefaultMaxNotificationContentSize is used if
+	// not specified
+	MaxContentSize int
+
+	base     Notification
+	executor templateExecutor
+}
+
+// NewNotificationTemplate parses the content template and creates the NotificationTemplate. The Category, Labels,
+// Description, Sender and Severity of the produced Notifications are copied from the base Notification.
+func NewNotificationTemplate(content string, contentType string, base Notification) (NotificationTemplate, errors.EdgeX) {
+	if len(strings.TrimSpace(content)) == 0 {
+		return NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "content template should not be empty", nil)
+	}
+	if len(content) > DefaultMaxNotificationContentSize {
+		return NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindLimitExceeded,
+			fmt.Sprintf("content template size %d exceeds the limit %d", len(content), DefaultMaxNotificationContentSize), nil)
+	}
+
+	var executor templateExecutor
+	var err error
+	if isHTMLContentType(contentType) {
+		executor, err = htmltemplate.New("content").Option("missingkey=error").Parse(content)
+	} else {
+		executor, err = texttemplate.New("content").Option("missingkey=error").Parse(content)
+	}
+	if err != nil {
+		return NotificationTemplate{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to parse the content template", err)
+	}
+
+	base.ContentType = contentType
+	return NotificationTemplate{base: base, executor: executor}, nil
+}
+
+// FromEvent renders the Notification with the Event, so the template can refer to the Event fields such as
+// {{.DeviceName}}, {{.SourceName}} and {{range .Readings}}{{.ResourceName}}={{.Value}}{{end}}
+func (t NotificationTemplate) FromEvent(event Event) (Notification, errors.EdgeX) {
+	return t.render(event)
+}
+
+// FromReading renders the Notification with the Reading, so the template can refer to the Reading fields such as
+// {{.DeviceName}}, {{.ResourceName}}, {{.ValueType}} and {{.Value}}
+func (t NotificationTemplate) FromReading(reading BaseReading) (Notification, errors.EdgeX) {
+	return t.render(reading)
+}
+
+func (t NotificationTemplate) render(data interface{}) (Notification, errors.EdgeX) {
+	if t.executor == nil {
+		return Notification{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "notification template is not initialized, use NewNotificationTemplate instead", nil)
+	}
+	limit := t.MaxContentSize
+	if limit <= 0 {
+		limit = DefaultMaxNotificationContentSize
+	}
+
+	w := &limitedWriter{limit: limit}
+	if err := t.executor.Execute(w, data); err != nil {
+		if w.exceeded {
+			return Notification{}, errors.NewCommonEdgeX(errors.KindLimitExceeded,
+				fmt.Sprintf("rendered content exceeds the limit %d", limit), nil)
+		}
+		return Notification{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to render the content template", err)
+	}
+
+	n := t.base
+	n.Id = uuid.NewString()
+	n.Content = w.buf.String()
+	if t.base.Labels != nil {
+		n.Labels = make([]string, len(t.base.Labels))
+		copy(n.Labels, t.base.Labels)
+	}
+	if err := v2.Validate(n); err != nil {
+		return Notification{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "invalid rendered Notification", err)
+	}
+	return n, nil
+}
+
+func isHTMLContentType(contentType string) bool {
+	return strings.HasPrefix(strings.ToLower(strings.TrimSpace(contentType)), clients.ContentTypeHTML)
+}
+
+// limitedWriter fails the template execution as soon as the rendered content exceeds the limit
+type limitedWriter struct {
+	buf      bytes.Buffer
+	limit    int
+	exceeded bool
+}
+
+func (w *limitedWriter) Write(p []byte) (int, error) {
+	if w.buf.Len()+len(p) > w.limit {
+		w.exceeded = true
+		return 0, io.ErrShortWrite
+	}
+	return w.buf.Write(p)
+}
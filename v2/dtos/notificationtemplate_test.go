@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func notificationTemplateBase() Notification {
+	return Notification{
+		Category:    "alarm",
+		Labels:      []string{"temperature"},
+		Description: "temperature alarm",
+		Sender:      "rules-engine",
+		Severity:    models.Critical,
+	}
+}
+
+func TestNewNotificationTemplate(t *testing.T) {
+	tests := []struct {
+		name        string
+		content     string
+		contentType string
+		errKind     errors.ErrKind
+	}{
+		{"valid, text template", "Device {{.DeviceName}}", clients.ContentTypeText, ""},
+		{"valid, html template", "<b>{{.DeviceName}}</b>", clients.ContentTypeHTML, ""},
+		{"valid, no content type", "Device {{.DeviceName}}", "", ""},
+		{"invalid, empty template", " ", clients.ContentTypeText, errors.KindContractInvalid},
+		{"invalid, malformed template", "Device {{.DeviceName", clients.ContentTypeText, errors.KindContractInvalid},
+		{"invalid, template too large", strings.Repeat("a", DefaultMaxNotificationContentSize+1), clients.ContentTypeText, errors.KindLimitExceeded},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewNotificationTemplate(tt.content, tt.contentType, notificationTemplateBase())
+			if tt.errKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.errKind, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestNotificationTemplate_FromReading(t *testing.T) {
+	reading, err := NewSimpleReading(TestDeviceProfileName, TestDeviceName, TestDeviceResourceName, v2.ValueTypeInt16, int16(90))
+	require.NoError(t, err)
+	tmpl, err := NewNotificationTemplate("Device {{.DeviceName}} reading {{.ResourceName}}={{.Value}}", clients.ContentTypeText, notificationTemplateBase())
+	require.NoError(t, err)
+
+	result, err := tmpl.FromReading(reading)
+	require.NoError(t, err)
+
+	base := notificationTemplateBase()
+	assert.NotEmpty(t, result.Id)
+	assert.Equal(t, "Device "+TestDeviceName+" reading "+TestDeviceResourceName+"=90", result.Content)
+	assert.Equal(t, clients.ContentTypeText, result.ContentType)
+	assert.Equal(t, base.Category, result.Category)
+	assert.Equal(t, base.Labels, result.Labels)
+	assert.Equal(t, base.Description, result.Description)
+	assert.Equal(t, base.Sender, result.Sender)
+	assert.Equal(t, base.Severity, result.Severity)
+
+	another, err := tmpl.FromReading(reading)
+	require.NoError(t, err)
+	assert.NotEqual(t, result.Id, another.Id)
+}
+
+func TestNotificationTemplate_FromEvent(t *testing.T) {
+	event := NewEvent(TestDeviceProfileName, TestDeviceName, TestSourceName)
+	require.NoError(t, event.AddSimpleReading("temperature", v2.ValueTypeFloat32, float32(1.5)))
+	require.NoError(t, event.AddSimpleReading("humidity", v2.ValueTypeUint8, uint8(40)))
+
+	tmpl, err := NewNotificationTemplate(
+		"{{.DeviceName}}/{{.SourceName}}:{{range .Readings}} {{.ResourceName}}={{.Value}}{{end}}",
+		clients.ContentTypeText, notificationTemplateBase())
+	require.NoError(t, err)
+
+	result, err := tmpl.FromEvent(event)
+	require.NoError(t, err)
+	assert.Equal(t, TestDeviceName+"/"+TestSourceName+": temperature=1.500000e+00 humidity=40", result.Content)
+}
+
+func TestNotificationTemplate_Escaping(t *testing.T) {
+	reading, err := NewSimpleReading(TestDeviceProfileName, TestDeviceName, TestDeviceResourceName, v2.ValueTypeString, "<script>alert(1)</script>")
+	require.NoError(t, err)
+
+	htmlTmpl, err := NewNotificationTemplate("<p>{{.Value}}</p>", clients.ContentTypeHTML, notificationTemplateBase())
+	require.NoError(t, err)
+	result, err := htmlTmpl.FromReading(reading)
+	require.NoError(t, err)
+	assert.Equal(t, "<p>&lt;script&gt;alert(1)&lt;/script&gt;</p>", result.Content)
+
+	textTmpl, err := NewNotificationTemplate("{{.Value}}", clients.ContentTypeText, notificationTemplateBase())
+	require.NoError(t, err)
+	result, err = textTmpl.FromReading(reading)
+	require.NoError(t, err)
+	assert.Equal(t, "<script>alert(1)</script>", result.Content)
+}
+
+func TestNotificationTemplate_RenderErrors(t *testing.T) {
+	reading, err := NewSimpleReading(TestDeviceProfileName, TestDeviceName, TestDeviceResourceName, v2.ValueTypeString, strings.Repeat("a", 100))
+	require.NoError(t, err)
+
+	tooLarge, err := NewNotificationTemplate("{{.Value}}", clients.ContentTypeText, notificationTemplateBase())
+	require.NoError(t, err)
+	tooLarge.MaxContentSize = 50
+	_, err = tooLarge.FromReading(reading)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+
+	unknownField, err := NewNotificationTemplate("{{.Unknown}}", clients.ContentTypeText, notificationTemplateBase())
+	require.NoError(t, err)
+	_, err = unknownField.FromReading(reading)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	noSender := notificationTemplateBase()
+	noSender.Sender = ""
+	invalidNotification, err := NewNotificationTemplate("{{.Value}}", clients.ContentTypeText, noSender)
+	require.NoError(t, err)
+	_, err = invalidNotification.FromReading(reading)
+	require.Error(t, err)
+
+	unknownSeverity := notificationTemplateBase()
+	unknownSeverity.Severity = "BOGUS"
+	invalidSeverity, err := NewNotificationTemplate("{{.Value}}", clients.ContentTypeText, unknownSeverity)
+	require.NoError(t, err)
+	_, err = invalidSeverity.FromReading(reading)
+	require.Error(t, err)
+
+	_, err = NotificationTemplate{}.FromReading(reading)
+	require.Error(t, err)
+}
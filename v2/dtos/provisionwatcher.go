@@ -16,8 +16,8 @@ type ProvisionWatcher struct {
 	Id                  string              `json:"id,omitempty" validate:"omitempty,uuid"`
 	Name                string              `json:"name" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
 	Labels              []string            `json:"labels,omitempty"`
-	Identifiers         map[string]string   `json:"identifiers" validate:"gt=0,dive,keys,required,endkeys,required"`
-	BlockingIdentifiers map[string][]string `json:"blockingIdentifiers,omitempty"`
+	Identifiers         map[string]string   `json:"identifiers" validate:"gt=0,dive,keys,required,endkeys,required,edgex-dto-regex"`
+	BlockingIdentifiers map[string][]string `json:"blockingIdentifiers,omitempty" validate:"omitempty,dive,keys,required,endkeys,gt=0,dive,required"`
 	ProfileName         string              `json:"profileName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
 	ServiceName         string              `json:"serviceName" validate:"required,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
 	AdminState          string              `json:"adminState" validate:"oneof='LOCKED' 'UNLOCKED'"`
@@ -30,8 +30,8 @@ type UpdateProvisionWatcher struct {
 	Id                  *string             `json:"id,omitempty" validate:"required_without=Name,edgex-dto-uuid"`
 	Name                *string             `json:"name,omitempty" validate:"required_without=Id,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
 	Labels              []string            `json:"labels,omitempty"`
-	Identifiers         map[string]string   `json:"identifiers,omitempty" validate:"omitempty,gt=0,dive,keys,required,endkeys,required"`
-	BlockingIdentifiers map[string][]string `json:"blockingIdentifiers,omitempty"`
+	Identifiers         map[string]string   `json:"identifiers,omitempty" validate:"omitempty,gt=0,dive,keys,required,endkeys,required,edgex-dto-regex"`
+	BlockingIdentifiers map[string][]string `json:"blockingIdentifiers,omitempty" validate:"omitempty,dive,keys,required,endkeys,gt=0,dive,required"`
 	ProfileName         *string             `json:"profileName,omitempty" validate:"omitempty,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
 	ServiceName         *string             `json:"serviceName,omitempty" validate:"omitempty,edgex-dto-none-empty-string,edgex-dto-rfc3986-unreserved-chars"`
 	AdminState          *string             `json:"adminState,omitempty" validate:"omitempty,oneof='LOCKED' 'UNLOCKED'"`
@@ -97,3 +97,22 @@ func FromProvisionWatcherModelToUpdateDTO(pw models.ProvisionWatcher) UpdateProv
 	}
 	return dto
 }
+
+// UpdateTo returns the minimal UpdateProvisionWatcher which updates the ProvisionWatcher to its newer version, or nil if both versions
+// are the same. The UpdateProvisionWatcher selects the ProvisionWatcher by the Name of the older version. The returned field mask lists
+// the JSON names of the fields to clear which the UpdateProvisionWatcher can't express, and is the FieldMask of its request.
+func (pw ProvisionWatcher) UpdateTo(newer ProvisionWatcher) (*UpdateProvisionWatcher, []string) {
+	var update UpdateProvisionWatcher
+	mask, changed := updateFields(pw, newer, &update)
+	if !changed {
+		return nil, nil
+	}
+	update.Name = &pw.Name
+	return &update, mask
+}
+
+// MarshalJSON implements the json.Marshaler interface to omit the nil fields but keep the empty slices and maps, as
+// an empty slice or map clears the field of the ProvisionWatcher while an absent one leaves it unchanged
+func (pw UpdateProvisionWatcher) MarshalJSON() ([]byte, error) {
+	return marshalUpdate(pw)
+}
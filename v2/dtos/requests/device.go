@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
 )
 
 // AddDeviceRequest defines the Request Content for POST Device DTO.
@@ -63,6 +64,8 @@ func AddDeviceReqToDeviceModels(addRequests []AddDeviceRequest) (Devices []model
 type UpdateDeviceRequest struct {
 	common.BaseRequest `json:",inline"`
 	Device             dtos.UpdateDevice `json:"device"`
+	// FieldMask lists the JSON names of the null fields of Device, which decode as nil like the absent fields
+	FieldMask []string `json:"-"`
 }
 
 // Validate satisfies the Validator interface
@@ -71,17 +74,28 @@ func (d UpdateDeviceRequest) Validate() error {
 	return err
 }
 
+// MarshalJSON implements the json.Marshaler interface to encode the fields of the FieldMask as null
+func (d UpdateDeviceRequest) MarshalJSON() ([]byte, error) {
+	return marshalUpdateRequest(d.BaseRequest, "device", d.Device, d.FieldMask)
+}
+
 // UnmarshalJSON implements the Unmarshaler interface for the UpdateDeviceRequest type
 func (d *UpdateDeviceRequest) UnmarshalJSON(b []byte) error {
 	var alias struct {
 		common.BaseRequest
-		Device dtos.UpdateDevice
+		Device    dtos.UpdateDevice
+		FieldMask []string `json:"-"`
 	}
 	if err := json.Unmarshal(b, &alias); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
 	}
 
 	*d = UpdateDeviceRequest(alias)
+	mask, err := fieldMask(b, "device", alias.Device)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	d.FieldMask = mask
 
 	// validate UpdateDeviceRequest DTO
 	if err := d.Validate(); err != nil {
@@ -90,44 +104,73 @@ func (d *UpdateDeviceRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ReplaceDeviceModelFieldsWithDTO replace existing Device's fields with DTO patch
+// ApplyDeviceUpdate replaces the existing Device's fields with the DTO patch. The nil fields of the DTO are unchanged
+// unless their JSON names are in the mask, in which case the fields are cleared. The Device is unchanged if the
+// AdminState or OperatingState of the DTO is not a legal transition from the Device's state, or the mask clears a
+// required field.
+func ApplyDeviceUpdate(device *models.Device, dto dtos.UpdateDevice, mask ...string) errors.EdgeX {
+	if err := ValidateDeviceStateTransitions(*device, dto); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return applyDeviceUpdate(device, dto, mask...)
+}
+
+// ReplaceDeviceModelFieldsWithDTO replace existing Device's fields with DTO patch.
+//
+// Deprecated: use ApplyDeviceUpdate, which also checks the state transitions, clears the fields of a mask and reports
+// the errors.
 func ReplaceDeviceModelFieldsWithDTO(device *models.Device, patch dtos.UpdateDevice) {
-	if patch.Description != nil {
-		device.Description = *patch.Description
+	// The states are replaced without checking the transitions, and the patch without a mask doesn't fail
+	_ = applyDeviceUpdate(device, patch)
+}
+
+// applyDeviceUpdate replaces the Device's fields with the DTO patch without checking the state transitions
+func applyDeviceUpdate(device *models.Device, dto dtos.UpdateDevice, mask ...string) errors.EdgeX {
+	fields, err := patch.FromUpdate(dto, mask...)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	// Notify is not carried by the Device DTO
+	_, notify := fields[notifyField]
+	delete(fields, notifyField)
+	patched := dtos.FromDeviceModelToDTO(*device)
+	if err := replaceModelFields(device, &patched, func() interface{} {
+		model := dtos.ToDeviceModel(patched)
+		return &model
+	}, fields); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if notify {
+		device.Notify = dto.Notify != nil && *dto.Notify
 	}
+	return nil
+}
+
+// ValidateDeviceStateTransitions checks whether the AdminState and OperatingState of the DTO patch are legal
+// transitions from the existing Device's states, which ApplyDeviceUpdate checks before the replacement.
+// An unset state of the Device can be patched to any valid state.
+func ValidateDeviceStateTransitions(device models.Device, patch dtos.UpdateDevice) errors.EdgeX {
 	if patch.AdminState != nil {
-		device.AdminState = models.AdminState(*patch.AdminState)
+		target := models.AdminState(*patch.AdminState)
+		err := target.Validate()
+		if device.AdminState != "" {
+			err = device.AdminState.ValidateTransition(target)
+		}
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
 	}
 	if patch.OperatingState != nil {
-		device.OperatingState = models.OperatingState(*patch.OperatingState)
-	}
-	if patch.LastConnected != nil {
-		device.LastConnected = *patch.LastConnected
-	}
-	if patch.LastReported != nil {
-		device.LastReported = *patch.LastReported
-	}
-	if patch.ServiceName != nil {
-		device.ServiceName = *patch.ServiceName
-	}
-	if patch.ProfileName != nil {
-		device.ProfileName = *patch.ProfileName
-	}
-	if patch.Labels != nil {
-		device.Labels = patch.Labels
-	}
-	if patch.Location != nil {
-		device.Location = patch.Location
-	}
-	if patch.AutoEvents != nil {
-		device.AutoEvents = dtos.ToAutoEventModels(patch.AutoEvents)
-	}
-	if patch.Protocols != nil {
-		device.Protocols = dtos.ToProtocolModels(patch.Protocols)
-	}
-	if patch.Notify != nil {
-		device.Notify = *patch.Notify
+		target := models.OperatingState(*patch.OperatingState)
+		err := target.Validate()
+		if device.OperatingState != "" {
+			err = device.OperatingState.ValidateTransition(target)
+		}
+		if err != nil {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
 	}
+	return nil
 }
 
 func NewAddDeviceRequest(dto dtos.Device) AddDeviceRequest {
@@ -11,6 +11,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
@@ -408,6 +409,29 @@ func TestUpdateDeviceRequest_UnmarshalJSON_EmptySlice(t *testing.T) {
 	assert.NotNil(t, req.Device.AutoEvents)
 }
 
+func TestUpdateDeviceRequest_UnmarshalJSON_NullField(t *testing.T) {
+	reqJson := `{
+		"apiVersion" : "v2",
+		"requestId":"7a1707f0-166f-4c4b-bc9d-1d54c74e0137",
+		"device":{"name":"TestDevice", "description":null, "location":null, "unknown":null}
+	}`
+	var req UpdateDeviceRequest
+
+	err := req.UnmarshalJSON([]byte(reqJson))
+
+	require.NoError(t, err)
+	// The null fields are nil as the absent fields, so they are listed in the FieldMask to be cleared
+	assert.Nil(t, req.Device.Description)
+	assert.Equal(t, []string{"description", "location"}, req.FieldMask)
+
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `"description":null`)
+	var decoded UpdateDeviceRequest
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, req, decoded)
+}
+
 func TestReplaceDeviceModelFieldsWithDTO(t *testing.T) {
 	device := models.Device{
 		Id:   "7a1707f0-166f-4c4b-bc9d-1d54c74e0137",
@@ -430,6 +454,122 @@ func TestReplaceDeviceModelFieldsWithDTO(t *testing.T) {
 	assert.Equal(t, dtos.ToProtocolModels(testProtocols), device.Protocols)
 }
 
+func TestApplyDeviceUpdate_Mask(t *testing.T) {
+	description := TestDescription
+	device := models.Device{
+		DBTimestamp: models.DBTimestamp{Created: testNowTime, Modified: testNowTime},
+		Id:          ExampleUUID,
+		Name:        TestDeviceName,
+		Description: "old description",
+		Labels:      testDeviceLabels,
+		Location:    testDeviceLocation,
+		ServiceName: TestDeviceServiceName,
+		Notify:      true,
+	}
+	patch := dtos.UpdateDevice{Description: &description, Labels: []string{}}
+
+	err := ApplyDeviceUpdate(&device, patch, "location", "notify")
+
+	require.NoError(t, err)
+	assert.Equal(t, TestDescription, device.Description)
+	assert.Empty(t, device.Labels)
+	assert.Nil(t, device.Location)
+	assert.False(t, device.Notify)
+	assert.Equal(t, TestDeviceServiceName, device.ServiceName)
+	assert.Equal(t, TestDeviceName, device.Name)
+	assert.Equal(t, models.DBTimestamp{Created: testNowTime, Modified: testNowTime}, device.DBTimestamp)
+}
+
+func TestApplyDeviceUpdate_InvalidMask(t *testing.T) {
+	device := models.Device{Name: TestDeviceName, Description: TestDescription}
+
+	err := ApplyDeviceUpdate(&device, dtos.UpdateDevice{}, "unknown")
+
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Equal(t, TestDescription, device.Description)
+}
+
+func TestApplyDeviceUpdate_ClearRequiredField(t *testing.T) {
+	tests := []string{"adminState", "operatingState", "serviceName", "profileName", "protocols"}
+	for _, field := range tests {
+		t.Run(field, func(t *testing.T) {
+			device := dtos.ToDeviceModel(testAddDevice.Device)
+			expected := device
+
+			err := ApplyDeviceUpdate(&device, dtos.UpdateDevice{}, field)
+
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Equal(t, expected, device)
+		})
+	}
+}
+
+func TestApplyDeviceUpdate_UpdateTo(t *testing.T) {
+	current := dtos.FromDeviceModelToDTO(models.Device{
+		Id:          ExampleUUID,
+		Name:        TestDeviceName,
+		Description: TestDescription,
+		Labels:      testDeviceLabels,
+		Location:    testDeviceLocation,
+		ServiceName: TestDeviceServiceName,
+		ProfileName: TestDeviceProfileName,
+		Protocols:   dtos.ToProtocolModels(testProtocols),
+	})
+	newer := current
+	newer.Description = "new description"
+	newer.Labels = []string{}
+	newer.Location = nil
+	newer.AutoEvents = testAutoEvents
+	update, mask := current.UpdateTo(newer)
+	require.NotNil(t, update)
+	req := NewUpdateDeviceRequest(*update)
+	req.FieldMask = mask
+
+	// The request is sent as JSON, where the cleared labels and location must not be lost
+	data, err := json.Marshal(req)
+	require.NoError(t, err)
+	var decoded UpdateDeviceRequest
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	device := dtos.ToDeviceModel(current)
+	err = ApplyDeviceUpdate(&device, decoded.Device, decoded.FieldMask...)
+
+	require.NoError(t, err)
+	assert.Equal(t, dtos.ToDeviceModel(newer), device)
+}
+
+func TestValidateDeviceStateTransitions(t *testing.T) {
+	up := models.Up
+	unknown := models.Unknown
+	invalid := "ENABLED"
+	device := models.Device{Name: TestDeviceName, AdminState: models.Unlocked, OperatingState: models.Down}
+
+	tests := []struct {
+		name         string
+		patch        dtos.UpdateDevice
+		expectedKind errors.ErrKind
+	}{
+		{"valid", mockUpdateDevice(), ""},
+		{"states not patched", dtos.UpdateDevice{}, ""},
+		{"operating state to up", dtos.UpdateDevice{OperatingState: &up}, ""},
+		{"operating state back to unknown", dtos.UpdateDevice{OperatingState: &unknown}, errors.KindStatusConflict},
+		{"invalid admin state", dtos.UpdateDevice{AdminState: &invalid}, errors.KindContractInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := ValidateDeviceStateTransitions(device, tt.patch)
+			if tt.expectedKind == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedKind, errors.Kind(err))
+		})
+	}
+}
+
 func TestNewAddDeviceRequest(t *testing.T) {
 	expectedApiVersion := v2.ApiVersion
 
@@ -445,3 +585,26 @@ func TestNewUpdateDeviceRequest(t *testing.T) {
 
 	assert.Equal(t, expectedApiVersion, actual.ApiVersion)
 }
+
+func TestApplyDeviceUpdate_StateTransition(t *testing.T) {
+	device := models.Device{Name: TestDeviceName, AdminState: models.Unlocked, OperatingState: models.Up}
+	unknown := models.Unknown
+	description := "description"
+
+	err := ApplyDeviceUpdate(&device, dtos.UpdateDevice{Description: &description, OperatingState: &unknown})
+
+	require.Error(t, err)
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+	assert.Empty(t, device.Description, "the device should be unchanged")
+	assert.Equal(t, models.OperatingState(models.Up), device.OperatingState)
+}
+
+func TestReplaceDeviceModelFieldsWithDTO_UncheckedStateTransition(t *testing.T) {
+	device := models.Device{Name: TestDeviceName, AdminState: models.Unlocked, OperatingState: models.Up}
+	unknown := models.Unknown
+
+	// The deprecated replacer keeps replacing the states without checking the transitions
+	ReplaceDeviceModelFieldsWithDTO(&device, dtos.UpdateDevice{OperatingState: &unknown})
+
+	assert.Equal(t, models.OperatingState(models.Unknown), device.OperatingState)
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
 )
 
 // AddDeviceServiceRequest defines the Request Content for POST DeviceService DTO.
@@ -63,6 +64,8 @@ func AddDeviceServiceReqToDeviceServiceModels(addRequests []AddDeviceServiceRequ
 type UpdateDeviceServiceRequest struct {
 	common.BaseRequest `json:",inline"`
 	Service            dtos.UpdateDeviceService `json:"service"`
+	// FieldMask lists the JSON names of the null fields of Service, which decode as nil like the absent fields
+	FieldMask []string `json:"-"`
 }
 
 // Validate satisfies the Validator interface
@@ -71,17 +74,28 @@ func (ds UpdateDeviceServiceRequest) Validate() error {
 	return err
 }
 
+// MarshalJSON implements the json.Marshaler interface to encode the fields of the FieldMask as null
+func (ds UpdateDeviceServiceRequest) MarshalJSON() ([]byte, error) {
+	return marshalUpdateRequest(ds.BaseRequest, "service", ds.Service, ds.FieldMask)
+}
+
 // UnmarshalJSON implements the Unmarshaler interface for the UpdateDeviceServiceRequest type
 func (ds *UpdateDeviceServiceRequest) UnmarshalJSON(b []byte) error {
 	var alias struct {
 		common.BaseRequest
-		Service dtos.UpdateDeviceService
+		Service   dtos.UpdateDeviceService
+		FieldMask []string `json:"-"`
 	}
 	if err := json.Unmarshal(b, &alias); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
 	}
 
 	*ds = UpdateDeviceServiceRequest(alias)
+	mask, err := fieldMask(b, "service", alias.Service)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	ds.FieldMask = mask
 
 	// validate UpdateDeviceServiceRequest DTO
 	if err := ds.Validate(); err != nil {
@@ -90,26 +104,27 @@ func (ds *UpdateDeviceServiceRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ReplaceDeviceServiceModelFieldsWithDTO replace existing DeviceService's fields with DTO patch
-func ReplaceDeviceServiceModelFieldsWithDTO(ds *models.DeviceService, patch dtos.UpdateDeviceService) {
-	if patch.Description != nil {
-		ds.Description = *patch.Description
-	}
-	if patch.LastConnected != nil {
-		ds.LastConnected = *patch.LastConnected
-	}
-	if patch.LastReported != nil {
-		ds.LastReported = *patch.LastReported
-	}
-	if patch.AdminState != nil {
-		ds.AdminState = models.AdminState(*patch.AdminState)
-	}
-	if patch.Labels != nil {
-		ds.Labels = patch.Labels
-	}
-	if patch.BaseAddress != nil {
-		ds.BaseAddress = *patch.BaseAddress
+// ApplyDeviceServiceUpdate replaces the existing DeviceService's fields with the DTO patch. The nil fields of the DTO are unchanged
+// unless their JSON names are in the mask, in which case the fields are cleared. The DeviceService is unchanged if the mask
+// clears a required field.
+func ApplyDeviceServiceUpdate(ds *models.DeviceService, dto dtos.UpdateDeviceService, mask ...string) errors.EdgeX {
+	fields, err := patch.FromUpdate(dto, mask...)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
 	}
+	patched := dtos.FromDeviceServiceModelToDTO(*ds)
+	return replaceModelFields(ds, &patched, func() interface{} {
+		model := dtos.ToDeviceServiceModel(patched)
+		return &model
+	}, fields)
+}
+
+// ReplaceDeviceServiceModelFieldsWithDTO replace existing DeviceService's fields with DTO patch.
+//
+// Deprecated: use ApplyDeviceServiceUpdate, which also clears the fields of a mask and reports the errors.
+func ReplaceDeviceServiceModelFieldsWithDTO(ds *models.DeviceService, patch dtos.UpdateDeviceService) {
+	// The patch without a mask doesn't clear any field, so it doesn't fail
+	_ = ApplyDeviceServiceUpdate(ds, patch)
 }
 
 func NewAddDeviceServiceRequest(dto dtos.DeviceService) AddDeviceServiceRequest {
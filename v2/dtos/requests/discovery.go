@@ -0,0 +1,54 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+)
+
+// DiscoveryRequest defines the Request Content for POST Discovery, which triggers the device discovery on a device
+// service. The Options are specific to the protocols of the device service, e.g. the subnets to scan.
+type DiscoveryRequest struct {
+	common.BaseRequest `json:",inline"`
+	Options            map[string]string `json:"options,omitempty" validate:"omitempty,dive,keys,required,endkeys"`
+}
+
+// NewDiscoveryRequest creates, initializes and returns a DiscoveryRequest
+func NewDiscoveryRequest(options map[string]string) DiscoveryRequest {
+	return DiscoveryRequest{
+		BaseRequest: common.NewBaseRequest(),
+		Options:     options,
+	}
+}
+
+// Validate satisfies the Validator interface
+func (request DiscoveryRequest) Validate() error {
+	err := v2.Validate(request)
+	return err
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the DiscoveryRequest type
+func (request *DiscoveryRequest) UnmarshalJSON(b []byte) error {
+	var alias struct {
+		common.BaseRequest
+		Options map[string]string
+	}
+	if err := json.Unmarshal(b, &alias); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+
+	*request = DiscoveryRequest(alias)
+
+	// validate DiscoveryRequest DTO
+	if err := request.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
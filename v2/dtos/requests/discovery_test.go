@@ -0,0 +1,84 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func discoveryRequestData() DiscoveryRequest {
+	return DiscoveryRequest{
+		BaseRequest: common.BaseRequest{
+			RequestId:   ExampleUUID,
+			Versionable: common.NewVersionable(),
+		},
+		Options: map[string]string{"subnet": "192.168.0.0/24"},
+	}
+}
+
+func TestDiscoveryRequest_Validate(t *testing.T) {
+	valid := discoveryRequestData()
+	noOptions := discoveryRequestData()
+	noOptions.Options = nil
+	invalidReqId := discoveryRequestData()
+	invalidReqId.RequestId = "abc"
+	emptyOptionKey := discoveryRequestData()
+	emptyOptionKey.Options = map[string]string{"": "value"}
+
+	tests := []struct {
+		name        string
+		request     DiscoveryRequest
+		expectError bool
+	}{
+		{"valid DiscoveryRequest", valid, false},
+		{"valid DiscoveryRequest, no options", noOptions, false},
+		{"invalid DiscoveryRequest, invalid request id", invalidReqId, true},
+		{"invalid DiscoveryRequest, empty option key", emptyOptionKey, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			assert.Equal(t, tt.expectError, err != nil, "Unexpected DiscoveryRequest validation result.", err)
+		})
+	}
+}
+
+func TestDiscoveryRequest_UnmarshalJSON(t *testing.T) {
+	valid := discoveryRequestData()
+	resultTestBytes, _ := json.Marshal(valid)
+	invalidReqId := discoveryRequestData()
+	invalidReqId.RequestId = "abc"
+	invalidTestBytes, _ := json.Marshal(invalidReqId)
+
+	tests := []struct {
+		name        string
+		data        []byte
+		expectError bool
+	}{
+		{"unmarshal DiscoveryRequest with success", resultTestBytes, false},
+		{"unmarshal invalid DiscoveryRequest, invalid request id", invalidTestBytes, true},
+		{"unmarshal invalid DiscoveryRequest, empty data", []byte{}, true},
+		{"unmarshal invalid DiscoveryRequest, string data", []byte("Invalid DiscoveryRequest"), true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result DiscoveryRequest
+			err := result.UnmarshalJSON(tt.data)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, valid, result, "Unmarshal did not result in expected DiscoveryRequest.")
+		})
+	}
+}
@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
 )
 
 // AddIntervalRequest defines the Request Content for POST Interval DTO.
@@ -63,6 +64,8 @@ func AddIntervalReqToIntervalModels(addRequests []AddIntervalRequest) (intervals
 type UpdateIntervalRequest struct {
 	common.BaseRequest `json:",inline"`
 	Interval           dtos.UpdateInterval `json:"interval"`
+	// FieldMask lists the JSON names of the null fields of Interval, which decode as nil like the absent fields
+	FieldMask []string `json:"-"`
 }
 
 // Validate satisfies the Validator interface
@@ -71,17 +74,28 @@ func (request UpdateIntervalRequest) Validate() error {
 	return err
 }
 
+// MarshalJSON implements the json.Marshaler interface to encode the fields of the FieldMask as null
+func (request UpdateIntervalRequest) MarshalJSON() ([]byte, error) {
+	return marshalUpdateRequest(request.BaseRequest, "interval", request.Interval, request.FieldMask)
+}
+
 // UnmarshalJSON implements the Unmarshaler interface for the UpdateIntervalRequest type
 func (request *UpdateIntervalRequest) UnmarshalJSON(b []byte) error {
 	var alias struct {
 		common.BaseRequest
-		Interval dtos.UpdateInterval
+		Interval  dtos.UpdateInterval
+		FieldMask []string `json:"-"`
 	}
 	if err := json.Unmarshal(b, &alias); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
 	}
 
 	*request = UpdateIntervalRequest(alias)
+	mask, err := fieldMask(b, "interval", alias.Interval)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	request.FieldMask = mask
 
 	// validate UpdateIntervalRequest DTO
 	if err := request.Validate(); err != nil {
@@ -90,20 +104,27 @@ func (request *UpdateIntervalRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ReplaceIntervalModelFieldsWithDTO replace existing Interval's fields with DTO patch
-func ReplaceIntervalModelFieldsWithDTO(interval *models.Interval, patch dtos.UpdateInterval) {
-	if patch.Start != nil {
-		interval.Start = *patch.Start
-	}
-	if patch.End != nil {
-		interval.End = *patch.End
-	}
-	if patch.Interval != nil {
-		interval.Interval = *patch.Interval
-	}
-	if patch.RunOnce != nil {
-		interval.RunOnce = *patch.RunOnce
+// ApplyIntervalUpdate replaces the existing Interval's fields with the DTO patch. The nil fields of the DTO are unchanged
+// unless their JSON names are in the mask, in which case the fields are cleared. The Interval is unchanged if the mask
+// clears a required field.
+func ApplyIntervalUpdate(interval *models.Interval, dto dtos.UpdateInterval, mask ...string) errors.EdgeX {
+	fields, err := patch.FromUpdate(dto, mask...)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
 	}
+	patched := dtos.FromIntervalModelToDTO(*interval)
+	return replaceModelFields(interval, &patched, func() interface{} {
+		model := dtos.ToIntervalModel(patched)
+		return &model
+	}, fields)
+}
+
+// ReplaceIntervalModelFieldsWithDTO replace existing Interval's fields with DTO patch.
+//
+// Deprecated: use ApplyIntervalUpdate, which also clears the fields of a mask and reports the errors.
+func ReplaceIntervalModelFieldsWithDTO(interval *models.Interval, patch dtos.UpdateInterval) {
+	// The patch without a mask doesn't clear any field, so it doesn't fail
+	_ = ApplyIntervalUpdate(interval, patch)
 }
 
 func NewAddIntervalRequest(dto dtos.Interval) AddIntervalRequest {
@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
 )
 
 // AddIntervalRequest defines the Request Content for POST Interval DTO.
@@ -70,6 +71,8 @@ func AddIntervalActionReqToIntervalActionModels(addRequests []AddIntervalActionR
 type UpdateIntervalActionRequest struct {
 	common.BaseRequest `json:",inline"`
 	Action             dtos.UpdateIntervalAction `json:"action"`
+	// FieldMask lists the JSON names of the null fields of Action, which decode as nil like the absent fields
+	FieldMask []string `json:"-"`
 }
 
 // Validate satisfies the Validator interface
@@ -87,17 +90,28 @@ func (request UpdateIntervalActionRequest) Validate() error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaler interface to encode the fields of the FieldMask as null
+func (request UpdateIntervalActionRequest) MarshalJSON() ([]byte, error) {
+	return marshalUpdateRequest(request.BaseRequest, "action", request.Action, request.FieldMask)
+}
+
 // UnmarshalJSON implements the Unmarshaler interface for the UpdateIntervalActionRequest type
 func (request *UpdateIntervalActionRequest) UnmarshalJSON(b []byte) error {
 	var alias struct {
 		common.BaseRequest
-		Action dtos.UpdateIntervalAction
+		Action    dtos.UpdateIntervalAction
+		FieldMask []string `json:"-"`
 	}
 	if err := json.Unmarshal(b, &alias); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
 	}
 
 	*request = UpdateIntervalActionRequest(alias)
+	mask, err := fieldMask(b, "action", alias.Action)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	request.FieldMask = mask
 
 	// validate UpdateIntervalActionRequest DTO
 	if err := request.Validate(); err != nil {
@@ -106,23 +120,27 @@ func (request *UpdateIntervalActionRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ReplaceIntervalActionModelFieldsWithDTO replace existing IntervalAction's fields with DTO patch
-func ReplaceIntervalActionModelFieldsWithDTO(action *models.IntervalAction, patch dtos.UpdateIntervalAction) {
-	if patch.IntervalName != nil {
-		action.IntervalName = *patch.IntervalName
-	}
-	if patch.Address != nil {
-		action.Address = dtos.ToAddressModel(*patch.Address)
-	}
-	if patch.Content != nil {
-		action.Content = *patch.Content
-	}
-	if patch.ContentType != nil {
-		action.ContentType = *patch.Content
-	}
-	if patch.AdminState != nil {
-		action.AdminState = models.AdminState(*patch.AdminState)
+// ApplyIntervalActionUpdate replaces the existing IntervalAction's fields with the DTO patch. The nil fields of the DTO are unchanged
+// unless their JSON names are in the mask, in which case the fields are cleared. The IntervalAction is unchanged if the mask
+// clears a required field.
+func ApplyIntervalActionUpdate(action *models.IntervalAction, dto dtos.UpdateIntervalAction, mask ...string) errors.EdgeX {
+	fields, err := patch.FromUpdate(dto, mask...)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
 	}
+	patched := dtos.FromIntervalActionModelToDTO(*action)
+	return replaceModelFields(action, &patched, func() interface{} {
+		model := dtos.ToIntervalActionModel(patched)
+		return &model
+	}, fields)
+}
+
+// ReplaceIntervalActionModelFieldsWithDTO replace existing IntervalAction's fields with DTO patch.
+//
+// Deprecated: use ApplyIntervalActionUpdate, which also clears the fields of a mask and reports the errors.
+func ReplaceIntervalActionModelFieldsWithDTO(action *models.IntervalAction, patch dtos.UpdateIntervalAction) {
+	// The patch without a mask doesn't clear any field, so it doesn't fail
+	_ = ApplyIntervalActionUpdate(action, patch)
 }
 
 func NewAddIntervalActionRequest(dto dtos.IntervalAction) AddIntervalActionRequest {
@@ -265,3 +265,19 @@ func TestReplaceIntervalActionModelFieldsWithDTO(t *testing.T) {
 	assert.Equal(t, TestIntervalName, interval.IntervalName)
 	assert.Equal(t, expectedAddress, interval.Address)
 }
+
+func TestApplyIntervalActionUpdate_Content(t *testing.T) {
+	content := "new content"
+	action := models.IntervalAction{
+		Name:        TestIntervalActionName,
+		Content:     "content",
+		ContentType: "text/plain",
+	}
+
+	err := ApplyIntervalActionUpdate(&action, dtos.UpdateIntervalAction{Content: &content}, "contentType")
+
+	require.NoError(t, err)
+	assert.Equal(t, content, action.Content)
+	assert.Empty(t, action.ContentType)
+	assert.Nil(t, action.Address)
+}
@@ -0,0 +1,89 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package requests
+
+import (
+	"encoding/json"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
+)
+
+// JSON names of the identifiers of the Update DTOs, which select the entity to update and are not replaced
+const (
+	idField   = "id"
+	nameField = "name"
+	// notifyField is the JSON name of UpdateDevice.Notify
+	notifyField = "notify"
+)
+
+// replaceModelFields applies the fields of the Update DTO to the model through the DTO of the model. The DTO is
+// patched and converted to the patched model by toModel, and only the patched fields are copied to the model, so that
+// the fields not carried by the DTO, e.g. the timestamps, are kept.
+// The fields cleared by the mask, which the validation of the Update DTO doesn't check, are validated with the DTO of
+// the model, so that a required field can't be cleared.
+func replaceModelFields(model interface{}, dto interface{}, toModel func() interface{}, fields patch.Fields) errors.EdgeX {
+	delete(fields, idField)
+	delete(fields, nameField)
+	cleared, err := fields.Nulls().ApplyTo(dto)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	names, err := fields.ApplyTo(dto)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(cleared) > 0 {
+		if err := v2.ValidateFields(dto, cleared...); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "the fields can't be cleared", err)
+		}
+	}
+	if err := patch.CopyFields(model, toModel(), names); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	return nil
+}
+
+// fieldMask returns the JSON names of the null fields of the Update DTO object of the key in the request, which the
+// JSON decoding can't tell from the absent fields. The key is matched case-insensitively as the JSON decoding does.
+func fieldMask(request []byte, key string, dto interface{}) ([]string, errors.EdgeX) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(request, &members); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
+	}
+	for name, object := range members {
+		if strings.EqualFold(name, key) {
+			mask, err := patch.NullFields(object, dto)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			return mask, nil
+		}
+	}
+	return nil, nil
+}
+
+// marshalUpdateRequest encodes the Update request of the BaseRequest and the Update DTO of the key, where the fields
+// in the mask are encoded as null
+func marshalUpdateRequest(base common.BaseRequest, key string, dto interface{}, mask []string) ([]byte, error) {
+	fields, err := patch.FromUpdate(dto, mask...)
+	if err != nil {
+		return nil, err
+	}
+	data, e := json.Marshal(base)
+	if e != nil {
+		return nil, e
+	}
+	var request map[string]interface{}
+	if e = json.Unmarshal(data, &request); e != nil {
+		return nil, e
+	}
+	request[key] = fields
+	return json.Marshal(request)
+}
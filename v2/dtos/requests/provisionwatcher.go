@@ -13,6 +13,7 @@ import (
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
 )
 
 // AddProvisionWatcherRequest defines the Request Content for POST ProvisionWatcher DTO.
@@ -63,6 +64,8 @@ func AddProvisionWatcherReqToProvisionWatcherModels(addRequests []AddProvisionWa
 type UpdateProvisionWatcherRequest struct {
 	common.BaseRequest `json:",inline"`
 	ProvisionWatcher   dtos.UpdateProvisionWatcher `json:"provisionWatcher"`
+	// FieldMask lists the JSON names of the null fields of ProvisionWatcher, which decode as nil like the absent fields
+	FieldMask []string `json:"-"`
 }
 
 // Validate satisfies the Validator interface
@@ -71,17 +74,28 @@ func (pw UpdateProvisionWatcherRequest) Validate() error {
 	return err
 }
 
+// MarshalJSON implements the json.Marshaler interface to encode the fields of the FieldMask as null
+func (pw UpdateProvisionWatcherRequest) MarshalJSON() ([]byte, error) {
+	return marshalUpdateRequest(pw.BaseRequest, "provisionWatcher", pw.ProvisionWatcher, pw.FieldMask)
+}
+
 // UnmarshalJSON implements the Unmarshaler interface for the UpdateProvisionWatcherRequest type
 func (pw *UpdateProvisionWatcherRequest) UnmarshalJSON(b []byte) error {
 	var alias struct {
 		common.BaseRequest
 		ProvisionWatcher dtos.UpdateProvisionWatcher
+		FieldMask        []string `json:"-"`
 	}
 	if err := json.Unmarshal(b, &alias); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
 	}
 
 	*pw = UpdateProvisionWatcherRequest(alias)
+	mask, err := fieldMask(b, "provisionWatcher", alias.ProvisionWatcher)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	pw.FieldMask = mask
 
 	// validate UpdateDeviceRequest DTO
 	if err := pw.Validate(); err != nil {
@@ -90,29 +104,27 @@ func (pw *UpdateProvisionWatcherRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ReplaceProvisionWatcherModelFieldsWithDTO replace existing ProvisionWatcher's fields with DTO patch
+// ApplyProvisionWatcherUpdate replaces the existing ProvisionWatcher's fields with the DTO patch. The nil fields of the DTO are unchanged
+// unless their JSON names are in the mask, in which case the fields are cleared. The ProvisionWatcher is unchanged if the mask
+// clears a required field.
+func ApplyProvisionWatcherUpdate(pw *models.ProvisionWatcher, dto dtos.UpdateProvisionWatcher, mask ...string) errors.EdgeX {
+	fields, err := patch.FromUpdate(dto, mask...)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	patched := dtos.FromProvisionWatcherModelToDTO(*pw)
+	return replaceModelFields(pw, &patched, func() interface{} {
+		model := dtos.ToProvisionWatcherModel(patched)
+		return &model
+	}, fields)
+}
+
+// ReplaceProvisionWatcherModelFieldsWithDTO replace existing ProvisionWatcher's fields with DTO patch.
+//
+// Deprecated: use ApplyProvisionWatcherUpdate, which also clears the fields of a mask and reports the errors.
 func ReplaceProvisionWatcherModelFieldsWithDTO(pw *models.ProvisionWatcher, patch dtos.UpdateProvisionWatcher) {
-	if patch.Labels != nil {
-		pw.Labels = patch.Labels
-	}
-	if patch.Identifiers != nil {
-		pw.Identifiers = patch.Identifiers
-	}
-	if patch.BlockingIdentifiers != nil {
-		pw.BlockingIdentifiers = patch.BlockingIdentifiers
-	}
-	if patch.ProfileName != nil {
-		pw.ProfileName = *patch.ProfileName
-	}
-	if patch.ServiceName != nil {
-		pw.ServiceName = *patch.ServiceName
-	}
-	if patch.AdminState != nil {
-		pw.AdminState = models.AdminState(*patch.AdminState)
-	}
-	if patch.AutoEvents != nil {
-		pw.AutoEvents = dtos.ToAutoEventModels(patch.AutoEvents)
-	}
+	// The patch without a mask doesn't clear any field, so it doesn't fail
+	_ = ApplyProvisionWatcherUpdate(pw, patch)
 }
 
 func NewAddProvisionWatcherRequest(dto dtos.ProvisionWatcher) AddProvisionWatcherRequest {
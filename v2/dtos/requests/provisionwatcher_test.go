@@ -93,6 +93,22 @@ func TestAddProvisionWatcherRequest_Validate(t *testing.T) {
 	missingIdentifiersValue.ProvisionWatcher.Identifiers = map[string]string{
 		"key": "",
 	}
+	invalidIdentifiersRegex := testAddProvisionWatcher
+	invalidIdentifiersRegex.ProvisionWatcher.Identifiers = map[string]string{
+		"address": "[0-9",
+	}
+	emptyBlockingIdentifiersValues := testAddProvisionWatcher
+	emptyBlockingIdentifiersValues.ProvisionWatcher.BlockingIdentifiers = map[string][]string{
+		"port": {},
+	}
+	emptyBlockingIdentifiersValue := testAddProvisionWatcher
+	emptyBlockingIdentifiersValue.ProvisionWatcher.BlockingIdentifiers = map[string][]string{
+		"port": {"399", ""},
+	}
+	missingBlockingIdentifiersKey := testAddProvisionWatcher
+	missingBlockingIdentifiersKey.ProvisionWatcher.BlockingIdentifiers = map[string][]string{
+		"": {"399"},
+	}
 	noServiceName := testAddProvisionWatcher
 	noServiceName.ProvisionWatcher.ServiceName = emptyString
 	noProfileName := testAddProvisionWatcher
@@ -123,6 +139,10 @@ func TestAddProvisionWatcherRequest_Validate(t *testing.T) {
 		{"invalid AddProvisionWatcherRequest, no Identifiers", noIdentifiers, true},
 		{"invalid AddProvisionWatcherRequest, missing Identifiers key", missingIdentifiersKey, true},
 		{"invalid AddProvisionWatcherRequest, missing Identifiers value", missingIdentifiersValue, true},
+		{"invalid AddProvisionWatcherRequest, invalid Identifiers regular expression", invalidIdentifiersRegex, true},
+		{"invalid AddProvisionWatcherRequest, empty BlockingIdentifiers values", emptyBlockingIdentifiersValues, true},
+		{"invalid AddProvisionWatcherRequest, empty BlockingIdentifiers value", emptyBlockingIdentifiersValue, true},
+		{"invalid AddProvisionWatcherRequest, missing BlockingIdentifiers key", missingBlockingIdentifiersKey, true},
 		{"invalid AddProvisionWatcherRequest, no ServiceName", noServiceName, true},
 		{"invalid AddProvisionWatcherRequest, no ProfileName", noProfileName, true},
 		{"invalid AddProvisionWatcherRequest, invalid autoEvent frequency", invalidFrequency, true},
@@ -137,6 +157,17 @@ func TestAddProvisionWatcherRequest_Validate(t *testing.T) {
 	}
 }
 
+func TestAddProvisionWatcherRequest_Validate_ErrorMessageNamesIdentifierKey(t *testing.T) {
+	req := testAddProvisionWatcher
+	req.ProvisionWatcher.Identifiers = map[string]string{"address": "[0-9"}
+	req.ProvisionWatcher.BlockingIdentifiers = map[string][]string{"port": {""}}
+
+	err := req.Validate()
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "Identifiers[address] field should be a valid regular expression")
+	assert.Contains(t, err.Error(), "BlockingIdentifiers[port][0] field is required")
+}
+
 func TestAddProvisionWatcherRequest_UnmarshalJSON(t *testing.T) {
 	valid := testAddProvisionWatcher
 	resultTestBytes, _ := json.Marshal(testAddProvisionWatcher)
@@ -225,6 +256,12 @@ func TestUpdateProvisionWatcherRequest_Validate(t *testing.T) {
 	validNilIdentifiers.ProvisionWatcher.Identifiers = nil
 	invalidEmptyIdentifiers := valid
 	invalidEmptyIdentifiers.ProvisionWatcher.Identifiers = emptyMap
+	invalidIdentifiersRegex := valid
+	invalidIdentifiersRegex.ProvisionWatcher.Identifiers = map[string]string{"address": "(abc"}
+	validNilBlockingIdentifiers := valid
+	validNilBlockingIdentifiers.ProvisionWatcher.BlockingIdentifiers = nil
+	invalidBlockingIdentifiers := valid
+	invalidBlockingIdentifiers.ProvisionWatcher.BlockingIdentifiers = map[string][]string{"port": {""}}
 	// ServiceName
 	validNilServiceName := valid
 	validNilServiceName.ProvisionWatcher.ServiceName = nil
@@ -261,6 +298,9 @@ func TestUpdateProvisionWatcherRequest_Validate(t *testing.T) {
 
 		{"valid, nil identifiers", validNilIdentifiers, false},
 		{"invalid, empty identifiers", invalidEmptyIdentifiers, true},
+		{"invalid, invalid identifiers regular expression", invalidIdentifiersRegex, true},
+		{"valid, nil blocking identifiers", validNilBlockingIdentifiers, false},
+		{"invalid, empty blocking identifiers value", invalidBlockingIdentifiers, true},
 
 		{"valid, nil service name", validNilServiceName, false},
 		{"invalid, empty service name", invalidEmptyServiceName, true},
@@ -7,15 +7,17 @@ package requests
 
 import (
 	"encoding/json"
+	"fmt"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
 )
 
-var supportedChannelTypes = []string{v2.EMAIL, v2.REST}
+var supportedChannelTypes = []string{v2.EMAIL, v2.REST, v2.MQTT, v2.WEBHOOK}
 
 // AddSubscriptionRequest defines the Request Content for POST Subscription DTO.
 // This object and its properties correspond to the AddSubscriptionRequest object in the APIv2 specification:
@@ -36,7 +38,7 @@ func (request AddSubscriptionRequest) Validate() error {
 		if err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		} else if !contains(supportedChannelTypes, c.Type) {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, "MQTT is not valid type for Channel", nil)
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not valid type for Channel", c.Type), nil)
 		}
 	}
 	return nil
@@ -76,6 +78,8 @@ func AddSubscriptionReqToSubscriptionModels(reqs []AddSubscriptionRequest) (s []
 type UpdateSubscriptionRequest struct {
 	common.BaseRequest `json:",inline"`
 	Subscription       dtos.UpdateSubscription `json:"subscription"`
+	// FieldMask lists the JSON names of the null fields of Subscription, which decode as nil like the absent fields
+	FieldMask []string `json:"-"`
 }
 
 // Validate satisfies the Validator interface
@@ -89,7 +93,7 @@ func (request UpdateSubscriptionRequest) Validate() error {
 		if err != nil {
 			return errors.NewCommonEdgeXWrapper(err)
 		} else if !contains(supportedChannelTypes, c.Type) {
-			return errors.NewCommonEdgeX(errors.KindContractInvalid, "MQTT is not valid type for Channel", nil)
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not valid type for Channel", c.Type), nil)
 		}
 	}
 	if request.Subscription.Categories != nil && request.Subscription.Labels != nil &&
@@ -99,17 +103,28 @@ func (request UpdateSubscriptionRequest) Validate() error {
 	return nil
 }
 
+// MarshalJSON implements the json.Marshaler interface to encode the fields of the FieldMask as null
+func (request UpdateSubscriptionRequest) MarshalJSON() ([]byte, error) {
+	return marshalUpdateRequest(request.BaseRequest, "subscription", request.Subscription, request.FieldMask)
+}
+
 // UnmarshalJSON implements the Unmarshaler interface for the UpdateSubscriptionRequest type
 func (request *UpdateSubscriptionRequest) UnmarshalJSON(b []byte) error {
 	var alias struct {
 		common.BaseRequest
 		Subscription dtos.UpdateSubscription
+		FieldMask    []string `json:"-"`
 	}
 	if err := json.Unmarshal(b, &alias); err != nil {
 		return errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal request body as JSON.", err)
 	}
 
 	*request = UpdateSubscriptionRequest(alias)
+	mask, err := fieldMask(b, "subscription", alias.Subscription)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	request.FieldMask = mask
 
 	// validate UpdateSubscriptionRequest DTO
 	if err := request.Validate(); err != nil {
@@ -118,32 +133,27 @@ func (request *UpdateSubscriptionRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// ReplaceSubscriptionModelFieldsWithDTO replace existing Subscription's fields with DTO patch
-func ReplaceSubscriptionModelFieldsWithDTO(s *models.Subscription, patch dtos.UpdateSubscription) {
-	if patch.Channels != nil {
-		s.Channels = dtos.ToAddressModels(patch.Channels)
-	}
-	if patch.Categories != nil {
-		s.Categories = patch.Categories
-	}
-	if patch.Labels != nil {
-		s.Labels = patch.Labels
-	}
-	if patch.Description != nil {
-		s.Description = *patch.Description
-	}
-	if patch.Receiver != nil {
-		s.Receiver = *patch.Receiver
-	}
-	if patch.ResendLimit != nil {
-		s.ResendLimit = *patch.ResendLimit
-	}
-	if patch.ResendInterval != nil {
-		s.ResendInterval = *patch.ResendInterval
-	}
-	if patch.AdminState != nil {
-		s.AdminState = models.AdminState(*patch.AdminState)
+// ApplySubscriptionUpdate replaces the existing Subscription's fields with the DTO patch. The nil fields of the DTO are unchanged
+// unless their JSON names are in the mask, in which case the fields are cleared. The Subscription is unchanged if the mask
+// clears a required field.
+func ApplySubscriptionUpdate(s *models.Subscription, dto dtos.UpdateSubscription, mask ...string) errors.EdgeX {
+	fields, err := patch.FromUpdate(dto, mask...)
+	if err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
 	}
+	patched := dtos.FromSubscriptionModelToDTO(*s)
+	return replaceModelFields(s, &patched, func() interface{} {
+		model := dtos.ToSubscriptionModel(patched)
+		return &model
+	}, fields)
+}
+
+// ReplaceSubscriptionModelFieldsWithDTO replace existing Subscription's fields with DTO patch.
+//
+// Deprecated: use ApplySubscriptionUpdate, which also clears the fields of a mask and reports the errors.
+func ReplaceSubscriptionModelFieldsWithDTO(s *models.Subscription, patch dtos.UpdateSubscription) {
+	// The patch without a mask doesn't clear any field, so it doesn't fail
+	_ = ApplySubscriptionUpdate(s, patch)
 }
 
 func NewAddSubscriptionRequest(dto dtos.Subscription) AddSubscriptionRequest {
@@ -9,6 +9,7 @@ import (
 	"encoding/json"
 	"testing"
 
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
 
@@ -86,10 +87,27 @@ func TestAddSubscriptionRequest_Validate(t *testing.T) {
 	invalidEmailAddress.Subscription.Channels = []dtos.Address{
 		dtos.NewEmailAddress([]string{"test.example.com"}),
 	}
-	unsupportedChannelType := addSubscriptionRequestData()
-	unsupportedChannelType.Subscription.Channels = []dtos.Address{
+	validMQTTChannel := addSubscriptionRequestData()
+	validMQTTChannel.Subscription.Channels = []dtos.Address{
 		dtos.NewMQTTAddress("host", 123, "publisher", "topic"),
 	}
+	validWebhookChannel := addSubscriptionRequestData()
+	validWebhookChannel.Subscription.Channels = []dtos.Address{
+		dtos.NewWebhookAddress(v2.SchemeHTTPS, "host", 443, "/hooks", "POST"),
+	}
+	invalidMQTTQoS := addSubscriptionRequestData()
+	invalidMQTTQoS.Subscription.Channels = []dtos.Address{
+		dtos.NewMQTTAddress("host", 123, "publisher", "topic"),
+	}
+	invalidMQTTQoS.Subscription.Channels[0].QoS = 3
+	invalidMQTTTopic := addSubscriptionRequestData()
+	invalidMQTTTopic.Subscription.Channels = []dtos.Address{
+		dtos.NewMQTTAddress("host", 123, "publisher", "alarms/#"),
+	}
+	unsupportedChannel := addSubscriptionRequestData()
+	unsupportedChannel.Subscription.Channels = []dtos.Address{
+		{Type: unsupportedChannelType, Host: "host", Port: 123},
+	}
 
 	noCategories := addSubscriptionRequestData()
 	noCategories.Subscription.Categories = nil
@@ -122,8 +140,12 @@ func TestAddSubscriptionRequest_Validate(t *testing.T) {
 		{"invalid, no subscription name", noSubscriptionName, true},
 		{"invalid, subscription name containing reserved chars", subscriptionNameWithReservedChars, true},
 		{"invalid, no channels specified", noChannel, true},
+		{"valid, MQTT channel", validMQTTChannel, false},
+		{"valid, Webhook channel", validWebhookChannel, false},
 		{"invalid, email address is invalid", invalidEmailAddress, true},
-		{"invalid, unsupported channel type", unsupportedChannelType, true},
+		{"invalid, MQTT QoS is out of range", invalidMQTTQoS, true},
+		{"invalid, MQTT topic contains wildcard", invalidMQTTTopic, true},
+		{"invalid, unsupported channel type", unsupportedChannel, true},
 		{"invalid, no categories and labels specified", noCategoriesAndLabels, true},
 		{"invalid, unsupported category type", categoryNameWithReservedChar, true},
 		{"invalid, no receiver specified", noReceiver, true},
@@ -209,10 +231,18 @@ func TestUpdateSubscriptionRequest_Validate(t *testing.T) {
 	invalidEmailAddress.Subscription.Channels = []dtos.Address{
 		dtos.NewEmailAddress([]string{"test.example.com"}),
 	}
-	unsupportedChannelType := NewUpdateSubscriptionRequest(updateSubscriptionData())
-	unsupportedChannelType.Subscription.Channels = []dtos.Address{
+	validMQTTChannel := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	validMQTTChannel.Subscription.Channels = []dtos.Address{
 		dtos.NewMQTTAddress("host", 123, "publisher", "topic"),
 	}
+	invalidMQTTTopic := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	invalidMQTTTopic.Subscription.Channels = []dtos.Address{
+		dtos.NewMQTTAddress("host", 123, "publisher", "alarms/+/high"),
+	}
+	unsupportedChannel := NewUpdateSubscriptionRequest(updateSubscriptionData())
+	unsupportedChannel.Subscription.Channels = []dtos.Address{
+		{Type: unsupportedChannelType, Host: "host", Port: 123},
+	}
 	validWithoutChannels := NewUpdateSubscriptionRequest(updateSubscriptionData())
 	validWithoutChannels.Subscription.Channels = nil
 	invalidEmptyChannels := NewUpdateSubscriptionRequest(updateSubscriptionData())
@@ -256,8 +286,10 @@ func TestUpdateSubscriptionRequest_Validate(t *testing.T) {
 		{"invalid, invalid ID", invalidId, true},
 		{"valid, only name", validOnlyName, false},
 		{"invalid, empty name", invalidEmptyName, true},
+		{"valid, MQTT channel", validMQTTChannel, false},
 		{"invalid, email address is invalid", invalidEmailAddress, true},
-		{"invalid, unsupported channel type", unsupportedChannelType, true},
+		{"invalid, MQTT topic contains wildcard", invalidMQTTTopic, true},
+		{"invalid, unsupported channel type", unsupportedChannel, true},
 		{"invalid, category name containing reserved chars", categoryNameWithReservedChar, true},
 		{"invalid, receiver name containing reserved chars", receiverNameWithReservedChars, true},
 		{"invalid, resendInterval is not specified in ISO8601 format", invalidResendInterval, true},
@@ -0,0 +1,37 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+)
+
+// DiscoveryResponse defines the Response Content for triggering and querying the device discovery
+type DiscoveryResponse struct {
+	common.BaseResponse `json:",inline"`
+	Discovery           dtos.Discovery `json:"discovery"`
+}
+
+func NewDiscoveryResponse(requestId string, message string, statusCode int, discovery dtos.Discovery) DiscoveryResponse {
+	return DiscoveryResponse{
+		BaseResponse: common.NewBaseResponse(requestId, message, statusCode),
+		Discovery:    discovery,
+	}
+}
+
+// MultiDiscoveredDevicesResponse defines the Response Content for GET multiple DiscoveredDevice DTOs
+type MultiDiscoveredDevicesResponse struct {
+	common.BaseResponse `json:",inline"`
+	DiscoveredDevices   []dtos.DiscoveredDevice `json:"discoveredDevices"`
+}
+
+func NewMultiDiscoveredDevicesResponse(requestId string, message string, statusCode int, devices []dtos.DiscoveredDevice) MultiDiscoveredDevicesResponse {
+	return MultiDiscoveredDevicesResponse{
+		BaseResponse:      common.NewBaseResponse(requestId, message, statusCode),
+		DiscoveredDevices: devices,
+	}
+}
@@ -0,0 +1,43 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package responses
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewDiscoveryResponse(t *testing.T) {
+	expectedRequestId := "123456"
+	expectedStatusCode := 202
+	expectedMessage := "unit test message"
+	expectedDiscovery := dtos.Discovery{ServiceName: "test service", State: v2.DiscoveryStateRunning}
+	actual := NewDiscoveryResponse(expectedRequestId, expectedMessage, expectedStatusCode, expectedDiscovery)
+
+	assert.Equal(t, expectedRequestId, actual.RequestId)
+	assert.Equal(t, expectedStatusCode, actual.StatusCode)
+	assert.Equal(t, expectedMessage, actual.Message)
+	assert.Equal(t, expectedDiscovery, actual.Discovery)
+}
+
+func TestNewMultiDiscoveredDevicesResponse(t *testing.T) {
+	expectedRequestId := "123456"
+	expectedStatusCode := 200
+	expectedMessage := "unit test message"
+	expectedDevices := []dtos.DiscoveredDevice{
+		{Name: "test device1"},
+		{Name: "test device2"},
+	}
+	actual := NewMultiDiscoveredDevicesResponse(expectedRequestId, expectedMessage, expectedStatusCode, expectedDevices)
+
+	assert.Equal(t, expectedRequestId, actual.RequestId)
+	assert.Equal(t, expectedStatusCode, actual.StatusCode)
+	assert.Equal(t, expectedMessage, actual.Message)
+	assert.Equal(t, expectedDevices, actual.DiscoveredDevices)
+}
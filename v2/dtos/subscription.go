@@ -91,3 +91,22 @@ func FromSubscriptionModelsToDTOs(subscruptions []models.Subscription) []Subscri
 	}
 	return dtos
 }
+
+// UpdateTo returns the minimal UpdateSubscription which updates the Subscription to its newer version, or nil if both versions
+// are the same. The UpdateSubscription selects the Subscription by the Name of the older version. The returned field mask lists
+// the JSON names of the fields to clear which the UpdateSubscription can't express, and is the FieldMask of its request.
+func (s Subscription) UpdateTo(newer Subscription) (*UpdateSubscription, []string) {
+	var update UpdateSubscription
+	mask, changed := updateFields(s, newer, &update)
+	if !changed {
+		return nil, nil
+	}
+	update.Name = &s.Name
+	return &update, mask
+}
+
+// MarshalJSON implements the json.Marshaler interface to omit the nil fields but keep the empty slices and maps, as
+// an empty slice or map clears the field of the Subscription while an absent one leaves it unchanged
+func (s UpdateSubscription) MarshalJSON() ([]byte, error) {
+	return marshalUpdate(s)
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
+)
+
+// noneEmptyStringTag is the validation tag of the Update DTO fields which can't be set to an empty string
+const noneEmptyStringTag = "edgex-dto-none-empty-string"
+
+// marshalUpdate encodes the non-nil fields of the Update DTO, so the absent fields are omitted as the omitempty fields,
+// while the empty slices and maps, which clear the fields, are encoded as [] and {} instead of being omitted
+func marshalUpdate(update interface{}) ([]byte, error) {
+	fields, err := patch.FromUpdate(update)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(fields)
+}
+
+// updateFields sets the fields of the Update DTO pointed by update to the fields of newer which are different from the
+// fields of the same Go names of current, and returns the field mask along with false if no field is different. The Id
+// and Name fields, which select the entity to update, and the fields not in the Update DTO are skipped. An empty slice
+// or map of newer is set as an empty but non-nil value, which clears the field. The fields which the Update DTO can't
+// clear, i.e. a nil interface, which can't be told from an absent one, and an empty string not allowed by the
+// validation, are left unset, and their JSON names are returned as the field mask which clears them.
+func updateFields(current interface{}, newer interface{}, update interface{}) (mask []string, changed bool) {
+	c, n := reflect.ValueOf(current), reflect.ValueOf(newer)
+	u := reflect.ValueOf(update).Elem()
+	for i := 0; i < u.NumField(); i++ {
+		structField := u.Type().Field(i)
+		name := structField.Name
+		if name == "Id" || name == "Name" {
+			continue
+		}
+		cf, nf := c.FieldByName(name), n.FieldByName(name)
+		if !cf.IsValid() || !nf.IsValid() || equalValues(cf.Interface(), nf.Interface()) {
+			continue
+		}
+		field := u.Field(i)
+		switch field.Kind() {
+		case reflect.Ptr:
+			if nf.Kind() == reflect.String && nf.Len() == 0 &&
+				strings.Contains(structField.Tag.Get("validate"), noneEmptyStringTag) {
+				mask = append(mask, jsonName(structField))
+				break
+			}
+			value := reflect.New(nf.Type())
+			value.Elem().Set(nf)
+			field.Set(value)
+		case reflect.Slice:
+			if nf.Len() == 0 {
+				field.Set(reflect.MakeSlice(nf.Type(), 0, 0))
+				break
+			}
+			field.Set(nf)
+		case reflect.Map:
+			if nf.Len() == 0 {
+				field.Set(reflect.MakeMap(nf.Type()))
+				break
+			}
+			field.Set(nf)
+		case reflect.Interface:
+			if nf.IsNil() {
+				mask = append(mask, jsonName(structField))
+				break
+			}
+			field.Set(nf)
+		default:
+			continue
+		}
+		changed = true
+	}
+	return mask, changed
+}
+
+// jsonName returns the JSON name of the struct field
+func jsonName(field reflect.StructField) string {
+	if name := strings.Split(field.Tag.Get("json"), ",")[0]; name != "" {
+		return name
+	}
+	return field.Name
+}
+
+// equalValues returns true if the values are deeply equal, both empty, or encoded to the same JSON, e.g. a Location
+// decoded from JSON and the same Location built in Go
+func equalValues(old interface{}, new interface{}) bool {
+	if reflect.DeepEqual(old, new) || (isEmptyValue(old) && isEmptyValue(new)) {
+		return true
+	}
+	oldJSON, oldErr := json.Marshal(old)
+	newJSON, newErr := json.Marshal(new)
+	return oldErr == nil && newErr == nil && bytes.Equal(oldJSON, newJSON)
+}
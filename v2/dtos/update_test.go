@@ -0,0 +1,242 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package dtos
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func updateTestDevice() Device {
+	return Device{
+		DBTimestamp:    DBTimestamp{Created: 1, Modified: 2},
+		Id:             "7a1707f0-166f-4c4b-bc9d-1d54c74e0137",
+		Name:           "device1",
+		Description:    "description",
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         []string{"label1", "label2"},
+		Location:       map[string]interface{}{"lat": 45.0, "long": 40},
+		ServiceName:    "service1",
+		ProfileName:    "profile1",
+		AutoEvents:     []AutoEvent{{Interval: "1s", SourceName: "resource1"}},
+		Protocols:      map[string]ProtocolProperties{"modbus-tcp": {"Address": "localhost", "Port": "502"}},
+	}
+}
+
+func TestDevice_UpdateTo(t *testing.T) {
+	tests := []struct {
+		name     string
+		newer    func(*Device)
+		expected func(*UpdateDevice)
+	}{
+		{"description", func(d *Device) { d.Description = "new" },
+			func(u *UpdateDevice) { u.Description = stringPtr("new") }},
+		{"states", func(d *Device) { d.AdminState, d.OperatingState = models.Locked, models.Down },
+			func(u *UpdateDevice) {
+				u.AdminState, u.OperatingState = stringPtr(models.Locked), stringPtr(models.Down)
+			}},
+		{"labels", func(d *Device) { d.Labels = []string{"label3"} },
+			func(u *UpdateDevice) { u.Labels = []string{"label3"} }},
+		{"cleared labels", func(d *Device) { d.Labels = nil },
+			func(u *UpdateDevice) { u.Labels = []string{} }},
+		{"location", func(d *Device) { d.Location = "room1" },
+			func(u *UpdateDevice) { u.Location = "room1" }},
+		{"protocols", func(d *Device) { d.Protocols = map[string]ProtocolProperties{"other": {"Address": "1"}} },
+			func(u *UpdateDevice) { u.Protocols = map[string]ProtocolProperties{"other": {"Address": "1"}} }},
+		{"auto events", func(d *Device) { d.AutoEvents[0].OnChange = true },
+			func(u *UpdateDevice) {
+				u.AutoEvents = []AutoEvent{{Interval: "1s", SourceName: "resource1", OnChange: true}}
+			}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			current := updateTestDevice()
+			newer := updateTestDevice()
+			testCase.newer(&newer)
+			expected := UpdateDevice{Name: stringPtr(current.Name)}
+			testCase.expected(&expected)
+
+			update, mask := current.UpdateTo(newer)
+			require.NotNil(t, update)
+			assert.Equal(t, expected, *update)
+			assert.Empty(t, mask)
+		})
+	}
+}
+
+func TestDevice_UpdateTo_Unchanged(t *testing.T) {
+	current := updateTestDevice()
+	var decoded Device
+	data, err := json.Marshal(current)
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(data, &decoded))
+
+	tests := []struct {
+		name  string
+		newer func(*Device)
+	}{
+		{"same", func(d *Device) {}},
+		{"different id and timestamps", func(d *Device) { d.Id, d.Created, d.Modified = "", 0, 0 }},
+		{"location decoded from JSON", func(d *Device) { d.Location = decoded.Location }},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			newer := updateTestDevice()
+			testCase.newer(&newer)
+
+			update, mask := current.UpdateTo(newer)
+			assert.Nil(t, update)
+			assert.Nil(t, mask)
+		})
+	}
+
+	current.Labels = nil
+	newer := updateTestDevice()
+	newer.Labels = []string{}
+	update, _ := current.UpdateTo(newer)
+	assert.Nil(t, update, "empty and nil labels should be the same")
+}
+
+func TestDevice_UpdateTo_Mask(t *testing.T) {
+	tests := []struct {
+		name         string
+		newer        func(*Device)
+		expectedMask []string
+	}{
+		{"cleared description not allowed by the validation", func(d *Device) { d.Description = "" }, []string{"description"}},
+		{"cleared location", func(d *Device) { d.Location = nil }, []string{"location"}},
+		{"cleared location and new labels", func(d *Device) { d.Location, d.Labels = nil, []string{"label3"} }, []string{"location"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			current := updateTestDevice()
+			newer := updateTestDevice()
+			testCase.newer(&newer)
+
+			update, mask := current.UpdateTo(newer)
+			require.NotNil(t, update, "the fields cleared by the mask should be updated")
+			assert.Nil(t, update.Description)
+			assert.Nil(t, update.Location)
+			assert.Equal(t, stringPtr(current.Name), update.Name)
+			assert.Equal(t, testCase.expectedMask, mask)
+		})
+	}
+}
+
+func TestDeviceService_UpdateTo(t *testing.T) {
+	current := DeviceService{Name: "service1", BaseAddress: "http://localhost:59900", AdminState: models.Unlocked}
+	newer := current
+	update, _ := current.UpdateTo(newer)
+	assert.Nil(t, update)
+
+	current.Description = "description"
+	newer.BaseAddress = "http://localhost:59901"
+	update, mask := current.UpdateTo(newer)
+	assert.Empty(t, mask)
+	require.NotNil(t, update)
+	assert.Equal(t, UpdateDeviceService{
+		Name:        stringPtr("service1"),
+		Description: stringPtr(""),
+		BaseAddress: stringPtr("http://localhost:59901"),
+	}, *update)
+}
+
+func TestProvisionWatcher_UpdateTo(t *testing.T) {
+	current := ProvisionWatcher{
+		Name:        "watcher1",
+		Identifiers: map[string]string{"address": "localhost"},
+		ProfileName: "profile1",
+		ServiceName: "service1",
+		AdminState:  models.Unlocked,
+	}
+	newer := current
+	newer.BlockingIdentifiers = map[string][]string{"port": {"502"}}
+	newer.ServiceName = "service2"
+
+	update, mask := current.UpdateTo(newer)
+	assert.Empty(t, mask)
+	require.NotNil(t, update)
+	assert.Equal(t, UpdateProvisionWatcher{
+		Name:                stringPtr("watcher1"),
+		BlockingIdentifiers: map[string][]string{"port": {"502"}},
+		ServiceName:         stringPtr("service2"),
+	}, *update)
+	unchanged, _ := current.UpdateTo(current)
+	assert.Nil(t, unchanged)
+}
+
+func TestSubscription_UpdateTo(t *testing.T) {
+	current := Subscription{
+		Name:       "subscription1",
+		Channels:   []Address{NewEmailAddress([]string{"test@example.com"})},
+		Receiver:   "receiver1",
+		Categories: []string{"category1"},
+		AdminState: models.Unlocked,
+	}
+	newer := current
+	newer.Channels = []Address{NewRESTAddress("localhost", 59900, http.MethodPost)}
+	newer.ResendLimit = 3
+
+	update, mask := current.UpdateTo(newer)
+	assert.Empty(t, mask)
+	require.NotNil(t, update)
+	resendLimit := 3
+	assert.Equal(t, UpdateSubscription{
+		Name:        stringPtr("subscription1"),
+		Channels:    newer.Channels,
+		ResendLimit: &resendLimit,
+	}, *update)
+	unchanged, _ := current.UpdateTo(current)
+	assert.Nil(t, unchanged)
+}
+
+func TestInterval_UpdateTo(t *testing.T) {
+	current := Interval{Name: "interval1", Interval: "10m"}
+	newer := current
+	newer.RunOnce = true
+
+	update, mask := current.UpdateTo(newer)
+	assert.Empty(t, mask)
+	require.NotNil(t, update)
+	runOnce := true
+	assert.Equal(t, UpdateInterval{Name: stringPtr("interval1"), RunOnce: &runOnce}, *update)
+	unchanged, _ := current.UpdateTo(current)
+	assert.Nil(t, unchanged)
+}
+
+func TestIntervalAction_UpdateTo(t *testing.T) {
+	current := IntervalAction{
+		Name:         "action1",
+		IntervalName: "interval1",
+		Address:      NewRESTAddress("localhost", 59900, http.MethodPost),
+		AdminState:   models.Unlocked,
+	}
+	newer := current
+	newer.Address = NewRESTAddress("localhost", 59901, http.MethodPost)
+	newer.ContentType = "application/json"
+
+	update, mask := current.UpdateTo(newer)
+	assert.Empty(t, mask)
+	require.NotNil(t, update)
+	assert.Equal(t, UpdateIntervalAction{
+		Name:        stringPtr("action1"),
+		Address:     &newer.Address,
+		ContentType: stringPtr("application/json"),
+	}, *update)
+	unchanged, _ := current.UpdateTo(current)
+	assert.Nil(t, unchanged)
+}
+
+func stringPtr(s string) *string {
+	return &s
+}
@@ -0,0 +1,132 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package integrity checks the referential integrity across the metadata entities offline, i.e. whether the devices
+// and provision watchers refer to the existing device profiles and device services, and whether their AutoEvents
+// refer to the device resources or device commands of the profile.
+package integrity
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+// Constants for the IssueKind
+const (
+	MissingProfile         IssueKind = "MissingProfile"
+	MissingService         IssueKind = "MissingService"
+	UnknownAutoEventSource IssueKind = "UnknownAutoEventSource"
+)
+
+// Constants for the entity types which have references
+const (
+	EntityDevice           = "Device"
+	EntityProvisionWatcher = "ProvisionWatcher"
+)
+
+// IssueKind categorizes the integrity issues
+type IssueKind string
+
+// Issue is a dangling reference or a mismatched AutoEvent source of an entity
+type Issue struct {
+	Kind IssueKind
+	// EntityType is the type of the entity having the issue, i.e. EntityDevice or EntityProvisionWatcher
+	EntityType string
+	EntityName string
+	// Reference is the name of the missing profile, the missing service or the unknown AutoEvent source
+	Reference string
+}
+
+// String returns the readable description of the Issue
+func (i Issue) String() string {
+	switch i.Kind {
+	case MissingProfile:
+		return fmt.Sprintf("%s %s refers to the device profile %s which does not exist", i.EntityType, i.EntityName, i.Reference)
+	case MissingService:
+		return fmt.Sprintf("%s %s refers to the device service %s which does not exist", i.EntityType, i.EntityName, i.Reference)
+	case UnknownAutoEventSource:
+		return fmt.Sprintf("%s %s has the AutoEvent source %s which is neither a device resource nor a device command of its profile",
+			i.EntityType, i.EntityName, i.Reference)
+	default:
+		return fmt.Sprintf("%s %s has the issue %s with %s", i.EntityType, i.EntityName, i.Kind, i.Reference)
+	}
+}
+
+// Snapshot is a set of the metadata entities to be checked
+type Snapshot struct {
+	Devices           []dtos.Device           `json:"devices,omitempty"`
+	DeviceProfiles    []dtos.DeviceProfile    `json:"deviceProfiles,omitempty"`
+	DeviceServices    []dtos.DeviceService    `json:"deviceServices,omitempty"`
+	ProvisionWatchers []dtos.ProvisionWatcher `json:"provisionWatchers,omitempty"`
+}
+
+// Merge appends the entities of the other Snapshot
+func (s *Snapshot) Merge(other Snapshot) {
+	s.Devices = append(s.Devices, other.Devices...)
+	s.DeviceProfiles = append(s.DeviceProfiles, other.DeviceProfiles...)
+	s.DeviceServices = append(s.DeviceServices, other.DeviceServices...)
+	s.ProvisionWatchers = append(s.ProvisionWatchers, other.ProvisionWatchers...)
+}
+
+// Check reports all the integrity issues of the Snapshot, sorted by the entity type, the entity name and the kind.
+// An empty result means the Snapshot is consistent.
+func Check(s Snapshot) []Issue {
+	services := make(map[string]bool, len(s.DeviceServices))
+	for _, ds := range s.DeviceServices {
+		services[ds.Name] = true
+	}
+	// sources maps the profile name to the names of its device resources and device commands
+	sources := make(map[string]map[string]bool, len(s.DeviceProfiles))
+	for _, p := range s.DeviceProfiles {
+		names := make(map[string]bool)
+		for _, r := range p.DeviceResources {
+			names[r.Name] = true
+		}
+		for _, c := range p.DeviceCommands {
+			names[c.Name] = true
+		}
+		sources[p.Name] = names
+	}
+
+	var issues []Issue
+	for _, d := range s.Devices {
+		issues = append(issues, checkReferences(EntityDevice, d.Name, d.ProfileName, d.ServiceName, d.AutoEvents, services, sources)...)
+	}
+	for _, pw := range s.ProvisionWatchers {
+		issues = append(issues, checkReferences(EntityProvisionWatcher, pw.Name, pw.ProfileName, pw.ServiceName, pw.AutoEvents, services, sources)...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].EntityType != issues[j].EntityType {
+			return issues[i].EntityType < issues[j].EntityType
+		}
+		if issues[i].EntityName != issues[j].EntityName {
+			return issues[i].EntityName < issues[j].EntityName
+		}
+		return issues[i].Kind < issues[j].Kind
+	})
+	return issues
+}
+
+func checkReferences(entityType, name, profileName, serviceName string, autoEvents []dtos.AutoEvent,
+	services map[string]bool, sources map[string]map[string]bool) []Issue {
+	var issues []Issue
+	if !services[serviceName] {
+		issues = append(issues, Issue{Kind: MissingService, EntityType: entityType, EntityName: name, Reference: serviceName})
+	}
+	profileSources, ok := sources[profileName]
+	if !ok {
+		// The AutoEvent sources can't be checked without the profile
+		return append(issues, Issue{Kind: MissingProfile, EntityType: entityType, EntityName: name, Reference: profileName})
+	}
+	for _, ae := range autoEvents {
+		if !profileSources[ae.SourceName] {
+			issues = append(issues, Issue{Kind: UnknownAutoEventSource, EntityType: entityType, EntityName: name, Reference: ae.SourceName})
+		}
+	}
+	return issues
+}
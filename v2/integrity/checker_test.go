@@ -0,0 +1,149 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package integrity
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+func testSnapshot() Snapshot {
+	return Snapshot{
+		DeviceServices: []dtos.DeviceService{{Name: "service"}},
+		DeviceProfiles: []dtos.DeviceProfile{{
+			Name:            "profile",
+			DeviceResources: []dtos.DeviceResource{{Name: "temperature"}},
+			DeviceCommands:  []dtos.DeviceCommand{{Name: "all"}},
+		}},
+		Devices: []dtos.Device{{
+			Name: "device", ServiceName: "service", ProfileName: "profile",
+			AutoEvents: []dtos.AutoEvent{{Interval: "10s", SourceName: "temperature"}, {Interval: "1m", SourceName: "all"}},
+		}},
+		ProvisionWatchers: []dtos.ProvisionWatcher{{Name: "watcher", ServiceName: "service", ProfileName: "profile"}},
+	}
+}
+
+func TestCheck(t *testing.T) {
+	valid := testSnapshot()
+
+	dangling := testSnapshot()
+	dangling.Devices = append(dangling.Devices,
+		dtos.Device{Name: "orphan", ServiceName: "unknown-service", ProfileName: "unknown-profile",
+			AutoEvents: []dtos.AutoEvent{{Interval: "10s", SourceName: "humidity"}}},
+		dtos.Device{Name: "bad-source", ServiceName: "service", ProfileName: "profile",
+			AutoEvents: []dtos.AutoEvent{{Interval: "10s", SourceName: "humidity"}, {Interval: "10s", SourceName: "pressure"}}},
+	)
+	dangling.ProvisionWatchers[0].ProfileName = "unknown-profile"
+
+	tests := []struct {
+		name     string
+		snapshot Snapshot
+		expected []Issue
+	}{
+		{"valid", valid, nil},
+		{"empty", Snapshot{}, nil},
+		{"dangling references", dangling, []Issue{
+			{Kind: UnknownAutoEventSource, EntityType: EntityDevice, EntityName: "bad-source", Reference: "humidity"},
+			{Kind: UnknownAutoEventSource, EntityType: EntityDevice, EntityName: "bad-source", Reference: "pressure"},
+			{Kind: MissingProfile, EntityType: EntityDevice, EntityName: "orphan", Reference: "unknown-profile"},
+			{Kind: MissingService, EntityType: EntityDevice, EntityName: "orphan", Reference: "unknown-service"},
+			{Kind: MissingProfile, EntityType: EntityProvisionWatcher, EntityName: "watcher", Reference: "unknown-profile"},
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, Check(tt.snapshot))
+		})
+	}
+}
+
+func TestIssue_String(t *testing.T) {
+	issue := Issue{Kind: MissingProfile, EntityType: EntityDevice, EntityName: "device", Reference: "profile"}
+	assert.Equal(t, "Device device refers to the device profile profile which does not exist", issue.String())
+}
+
+const testProfileYAML = `
+name: "profile"
+deviceResources:
+  - name: "temperature"
+    properties:
+      valueType: "Float32"
+      readWrite: "R"
+`
+
+const testSnapshotYAML = `
+deviceServices:
+  - name: "service"
+devices:
+  - name: "device"
+    serviceName: "service"
+    profileName: "profile"
+    autoEvents:
+      - interval: "10s"
+        sourceName: "humidity"
+`
+
+const testSnapshotJSON = `{"provisionWatchers":[{"name":"watcher","serviceName":"service","profileName":"missing"}]}`
+
+func writeFile(t *testing.T, dir, name, content string) string {
+	path := filepath.Join(dir, name)
+	require.NoError(t, ioutil.WriteFile(path, []byte(content), 0600))
+	return path
+}
+
+func TestLoadFiles(t *testing.T) {
+	dir, err := ioutil.TempDir("", "integrity")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	profile := writeFile(t, dir, "profile.yml", testProfileYAML)
+	entities := writeFile(t, dir, "entities.yaml", testSnapshotYAML)
+	watchers := writeFile(t, dir, "watchers.json", testSnapshotJSON)
+
+	snapshot, edgexErr := LoadFiles(profile, entities, watchers)
+	require.NoError(t, edgexErr)
+	require.Len(t, snapshot.DeviceProfiles, 1)
+	assert.Equal(t, "temperature", snapshot.DeviceProfiles[0].DeviceResources[0].Name)
+	require.Len(t, snapshot.Devices, 1)
+	require.Len(t, snapshot.ProvisionWatchers, 1)
+
+	assert.Equal(t, []Issue{
+		{Kind: UnknownAutoEventSource, EntityType: EntityDevice, EntityName: "device", Reference: "humidity"},
+		{Kind: MissingProfile, EntityType: EntityProvisionWatcher, EntityName: "watcher", Reference: "missing"},
+	}, Check(snapshot))
+}
+
+func TestLoadFiles_Error(t *testing.T) {
+	dir, err := ioutil.TempDir("", "integrity")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name         string
+		path         string
+		expectedKind errors.ErrKind
+	}{
+		{"file not found", filepath.Join(dir, "missing.json"), errors.KindIOError},
+		{"unsupported extension", writeFile(t, dir, "entities.txt", testSnapshotJSON), errors.KindContractInvalid},
+		{"invalid JSON", writeFile(t, dir, "invalid.json", "{"), errors.KindContractInvalid},
+		{"invalid YAML", writeFile(t, dir, "invalid.yaml", "devices: ["), errors.KindContractInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := LoadFiles(tt.path)
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedKind, errors.Kind(err))
+		})
+	}
+}
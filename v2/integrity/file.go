@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package integrity
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+// LoadFiles reads the entities from the JSON or YAML files and merges them into a Snapshot. A file contains either a
+// Snapshot document with the devices, deviceProfiles, deviceServices and provisionWatchers keys, or a single device
+// profile such as the files uploaded to core-metadata.
+func LoadFiles(paths ...string) (Snapshot, errors.EdgeX) {
+	var snapshot Snapshot
+	for _, path := range paths {
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return Snapshot{}, errors.NewCommonEdgeX(errors.KindIOError, fmt.Sprintf("failed to read the file %s", path), err)
+		}
+		s, edgexErr := parse(data, filepath.Ext(path))
+		if edgexErr != nil {
+			return Snapshot{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the file %s", path), edgexErr)
+		}
+		snapshot.Merge(s)
+	}
+	return snapshot, nil
+}
+
+func parse(data []byte, ext string) (Snapshot, errors.EdgeX) {
+	switch strings.ToLower(ext) {
+	case ".json":
+	case ".yaml", ".yml":
+		// Only the DeviceProfile DTO has the YAML tags, while the Device, DeviceService and ProvisionWatcher DTOs
+		// only have the JSON tags, so the YAML document is converted to JSON first to decode all entities the same way
+		var doc interface{}
+		if err := yaml.Unmarshal(data, &doc); err != nil {
+			return Snapshot{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal the YAML document", err)
+		}
+		converted, err := toJSONCompatible(doc)
+		if err != nil {
+			return Snapshot{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		if data, err = marshalJSON(converted); err != nil {
+			return Snapshot{}, errors.NewCommonEdgeXWrapper(err)
+		}
+	default:
+		return Snapshot{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported file extension %s", ext), nil)
+	}
+
+	var keys map[string]json.RawMessage
+	if err := json.Unmarshal(data, &keys); err != nil {
+		return Snapshot{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "the document should be an object", err)
+	}
+	if _, ok := keys["deviceResources"]; ok {
+		var profile dtos.DeviceProfile
+		if err := json.Unmarshal(data, &profile); err != nil {
+			return Snapshot{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal the device profile", err)
+		}
+		return Snapshot{DeviceProfiles: []dtos.DeviceProfile{profile}}, nil
+	}
+	var snapshot Snapshot
+	if err := json.Unmarshal(data, &snapshot); err != nil {
+		return Snapshot{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal the entities", err)
+	}
+	return snapshot, nil
+}
+
+// toJSONCompatible converts the map[interface{}]interface{} decoded by yaml.v2 into map[string]interface{}
+func toJSONCompatible(v interface{}) (interface{}, errors.EdgeX) {
+	switch value := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(value))
+		for k, item := range value {
+			key, ok := k.(string)
+			if !ok {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the key %v should be a string", k), nil)
+			}
+			converted, err := toJSONCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = converted
+		}
+		return m, nil
+	case []interface{}:
+		for i, item := range value {
+			converted, err := toJSONCompatible(item)
+			if err != nil {
+				return nil, err
+			}
+			value[i] = converted
+		}
+		return value, nil
+	default:
+		return v, nil
+	}
+}
+
+func marshalJSON(v interface{}) ([]byte, errors.EdgeX) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to convert the YAML document to JSON", err)
+	}
+	return data, nil
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"reflect"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+)
+
+// documentDTOs are the DTOs which have the JSON Schema documents, i.e. the request and response DTOs and the device
+// profile which is also edited as a file
+var documentDTOs = []interface{}{
+	dtos.DeviceProfile{},
+	common.BaseRequest{},
+	common.BaseResponse{},
+	common.BaseWithIdResponse{},
+	common.ConfigResponse{},
+	common.MultiConfigsResponse{},
+	common.CountResponse{},
+	common.MetricsResponse{},
+	common.MultiMetricsResponse{},
+	common.PingResponse{},
+	common.SecretRequest{},
+	common.VersionResponse{},
+	common.VersionSdkResponse{},
+	requests.AddDeviceRequest{},
+	requests.UpdateDeviceRequest{},
+	requests.DeviceProfileRequest{},
+	requests.AddDeviceServiceRequest{},
+	requests.UpdateDeviceServiceRequest{},
+	requests.DiscoveryRequest{},
+	requests.AddEventRequest{},
+	requests.AddIntervalRequest{},
+	requests.UpdateIntervalRequest{},
+	requests.AddIntervalActionRequest{},
+	requests.UpdateIntervalActionRequest{},
+	requests.AddNotificationRequest{},
+	requests.OperationRequest{},
+	requests.AddProvisionWatcherRequest{},
+	requests.UpdateProvisionWatcherRequest{},
+	requests.AddSubscriptionRequest{},
+	requests.UpdateSubscriptionRequest{},
+	responses.DeviceCoreCommandResponse{},
+	responses.MultiDeviceCoreCommandsResponse{},
+	responses.DeviceResponse{},
+	responses.MultiDevicesResponse{},
+	responses.DeviceProfileResponse{},
+	responses.MultiDeviceProfilesResponse{},
+	responses.DeviceResourceResponse{},
+	responses.DeviceServiceResponse{},
+	responses.MultiDeviceServicesResponse{},
+	responses.DiscoveryResponse{},
+	responses.MultiDiscoveredDevicesResponse{},
+	responses.EventResponse{},
+	responses.MultiEventsResponse{},
+	responses.HealthResponse{},
+	responses.IntervalResponse{},
+	responses.MultiIntervalsResponse{},
+	responses.IntervalActionResponse{},
+	responses.MultiIntervalActionsResponse{},
+	responses.NotificationResponse{},
+	responses.MultiNotificationsResponse{},
+	responses.ProvisionWatcherResponse{},
+	responses.MultiProvisionWatchersResponse{},
+	responses.ReadingResponse{},
+	responses.MultiReadingsResponse{},
+	responses.SubscriptionResponse{},
+	responses.MultiSubscriptionsResponse{},
+	responses.TransmissionResponse{},
+	responses.MultiTransmissionsResponse{},
+}
+
+// Documents generates the JSON Schema documents of the request and response DTOs, keyed by the DTO type name
+func Documents() (map[string]*Schema, errors.EdgeX) {
+	documents := make(map[string]*Schema, len(documentDTOs))
+	for _, dto := range documentDTOs {
+		s, err := Generate(dto)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		documents[reflect.TypeOf(dto).Name()] = s
+	}
+	return documents, nil
+}
@@ -0,0 +1,785 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+)
+
+const (
+	uuidPattern = "^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$"
+	// dtoUuidPattern accepts the forms parsed by uuid.Parse, i.e. the standard form, the URN form, the form enclosed
+	// in braces and the form without hyphens
+	dtoUuidPattern = "^(" + hexUuid + "|[uU][rR][nN]:[uU][uU][iI][dD]:" + hexUuid + "|\\{" + hexUuid + "\\}|[0-9a-fA-F]{32})$"
+	hexUuid        = "[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}"
+	// noneEmptyStringPattern requires a non-whitespace character
+	noneEmptyStringPattern  = "\\S"
+	rfc3986UnreservedChars  = "^[a-zA-Z0-9\\-_.~]+$"
+	durationPattern         = "^[-+]?(0|(([0-9]+(\\.[0-9]*)?|\\.[0-9]+)(ns|us|µs|μs|ms|s|m|h))+)$"
+	intervalDatetimePattern = "^[0-9]{4}(0[1-9]|1[0-2])(0[1-9]|[12][0-9]|3[01])T([01][0-9]|2[0-3])[0-5][0-9][0-5][0-9]$"
+	mqttPublishTopicPattern = "^[^+#\\x00]+$"
+	httpHeaderNamePattern   = "^[a-zA-Z0-9!#$%&'*+\\-.^_`|~]+$"
+)
+
+// oneOfParamRegex splits the oneof parameter into the values, which are quoted if having the spaces
+var oneOfParamRegex = regexp.MustCompile(`'[^']*'|\S+`)
+
+const (
+	tagOmitEmpty       = "omitempty"
+	tagRequired        = "required"
+	tagRequiredWithout = "required_without"
+	tagRequiredUnless  = "required_unless"
+	tagDive            = "dive"
+	tagKeys            = "keys"
+	tagEndKeys         = "endkeys"
+)
+
+// Generate returns the JSON Schema document of the DTO, which is a struct or a pointer to a struct. The constraints
+// are translated from the validation tags as below:
+//   - required, required_without and required_unless become the required properties, including the properties
+//     whose zero value fails the other tags
+//   - oneof becomes enum; len, min, max, gt, gte, lt and lte become the length, count or range keywords
+//   - uuid and the edgex-dto-* tags become patterns; uri, email and edgex-dto-regex become formats, which are
+//     annotations only
+//   - dive, keys and endkeys constrain the array items, the object property names and the object property values
+//
+// An error is returned if the DTO has a validation tag which can't be translated.
+func Generate(dto interface{}) (*Schema, errors.EdgeX) {
+	t := reflect.TypeOf(dto)
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the DTO should be a struct, but got %v", t), nil)
+	}
+	g := generator{visiting: make(map[reflect.Type]bool)}
+	s, err := g.structSchema(t, true)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to generate the JSON Schema of %s", t.Name()), err)
+	}
+	s.Schema = Draft
+	s.Title = t.Name()
+	return s, nil
+}
+
+type generator struct {
+	visiting map[reflect.Type]bool
+}
+
+// field is a property of the object schema
+type field struct {
+	goName   string
+	name     string
+	typ      reflect.Type
+	schema   *Schema
+	required bool
+	// nonZero describes the property value which satisfies the required tag
+	nonZero *Schema
+	// requiredWithout and requiredUnless are the parameters of the conditional tags, which are resolved as the rules
+	// of the object as they refer to the other fields
+	requiredWithout []string
+	requiredUnless  []string
+}
+
+// constraints are the parsed validation tags of a field or an element
+type constraints struct {
+	first           string
+	required        bool
+	omitEmpty       bool
+	requiredWithout []string
+	requiredUnless  []string
+	// before and after are the translated tags before and after omitempty
+	before []*Schema
+	after  []*Schema
+	// items, keys and values are the schemas of the elements when the tags have dive
+	items  *Schema
+	keys   *Schema
+	values *Schema
+}
+
+// structSchema returns the object schema of the struct. The validation tags are ignored if not constrained, the same
+// as the validator which skips the fields tagged with "-".
+func (g *generator) structSchema(t reflect.Type, constrained bool) (*Schema, errors.EdgeX) {
+	if g.visiting[t] {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the recursive type %s is not supported", t.Name()), nil)
+	}
+	g.visiting[t] = true
+	defer delete(g.visiting, t)
+
+	fields, rules, err := g.structFields(t, constrained)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	s := &Schema{Type: Types{TypeObject}, Properties: make(map[string]*Schema, len(fields))}
+	for _, f := range fields {
+		s.Properties[f.name] = f.schema
+		if f.required {
+			s.Required = append(s.Required, f.name)
+		}
+	}
+	s.AllOf = rules
+	return s, nil
+}
+
+// structFields returns the fields of the struct with the fields of the embedded structs flattened as encoding/json
+// does, and the rules of the required_without and required_unless tags
+func (g *generator) structFields(t reflect.Type, constrained bool) ([]*field, []*Schema, errors.EdgeX) {
+	var fields []*field
+	var rules []*Schema
+	// The shallower fields hide the fields of the embedded structs with the same name
+	var own, embedded []*field
+	for i := 0; i < t.NumField(); i++ {
+		sf := t.Field(i)
+		name, ok := jsonName(sf)
+		if !ok {
+			continue
+		}
+		fieldConstrained := constrained && sf.Tag.Get("validate") != "-"
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			inner, innerRules, err := g.structFields(sf.Type, fieldConstrained)
+			if err != nil {
+				return nil, nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			fields = append(fields, inner...)
+			embedded = append(embedded, inner...)
+			rules = append(rules, innerRules...)
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+		f, err := g.field(sf, name, fieldConstrained)
+		if err != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid field %s.%s", t.Name(), sf.Name), err)
+		}
+		fields = append(fields, f)
+		own = append(own, f)
+	}
+
+	byGoName := make(map[string]*field, len(own))
+	for _, f := range own {
+		byGoName[f.goName] = f
+	}
+	for _, f := range own {
+		for _, other := range f.requiredWithout {
+			o, ok := byGoName[other]
+			if !ok {
+				return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown field %s of %s.%s", other, t.Name(), f.goName), nil)
+			}
+			// The field is required without the other field, i.e. at least one of them is present
+			pair := []*field{f, o}
+			sort.Slice(pair, func(i, j int) bool { return pair[i].name < pair[j].name })
+			rules = appendRule(rules, &Schema{AnyOf: []*Schema{presence(pair[0]), presence(pair[1])}})
+		}
+		if len(f.requiredUnless) > 0 {
+			rule := &Schema{AnyOf: []*Schema{presence(f)}}
+			for i := 0; i+1 < len(f.requiredUnless); i += 2 {
+				o, ok := byGoName[f.requiredUnless[i]]
+				if !ok {
+					return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown field %s of %s.%s", f.requiredUnless[i], t.Name(), f.goName), nil)
+				}
+				value, err := parseValue(o.typ, f.requiredUnless[i+1])
+				if err != nil {
+					return nil, nil, errors.NewCommonEdgeXWrapper(err)
+				}
+				rule.AnyOf = append(rule.AnyOf, &Schema{Required: []string{o.name}, Properties: map[string]*Schema{o.name: {Const: value}}})
+			}
+			rules = appendRule(rules, rule)
+		}
+	}
+
+	names := make(map[string]bool, len(own))
+	for _, f := range own {
+		names[f.name] = true
+	}
+	hidden := make(map[*field]bool)
+	for _, f := range embedded {
+		if names[f.name] {
+			hidden[f] = true
+		}
+		names[f.name] = true
+	}
+	visible := fields[:0]
+	for _, f := range fields {
+		if !hidden[f] {
+			visible = append(visible, f)
+		}
+	}
+	fields = visible
+	return fields, rules, nil
+}
+
+// field returns the property of the struct field
+func (g *generator) field(sf reflect.StructField, name string, constrained bool) (*field, errors.EdgeX) {
+	t := sf.Type
+	nullable := t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	f := &field{goName: sf.Name, name: name, typ: t}
+
+	var tags []string
+	if constrained {
+		tags = splitTags(sf.Tag.Get("validate"))
+	}
+	c, err := g.constraints(t, tags)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	s, err := g.typeSchema(t, constrained)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	c.applyElements(s)
+	f.requiredWithout = c.requiredWithout
+	f.requiredUnless = c.requiredUnless
+
+	if nullable {
+		// A nil pointer passes omitempty and the conditional tags, fails required, and fails the other tags which
+		// aren't designed to skip the nil values
+		switch c.first {
+		case "", tagOmitEmpty, tagRequiredWithout, tagRequiredUnless:
+		default:
+			f.required = true
+		}
+		for _, constraint := range append(c.before, c.after...) {
+			merge(s, constraint)
+		}
+		f.nonZero = &Schema{Not: &Schema{Type: Types{TypeNull}}}
+		if !f.required {
+			s = withNull(s)
+		}
+		f.schema = s
+		return f, nil
+	}
+
+	// The required tag is ignored for the structs, whose fields are validated instead
+	f.nonZero = nonZero(t)
+	if c.required && f.nonZero != nil {
+		merge(s, f.nonZero)
+		f.required = true
+	}
+	for _, constraint := range c.before {
+		merge(s, constraint)
+	}
+	// The missing property is decoded as the zero value, so the property is required if the zero value is invalid
+	if s.Validate(zeroJSON(t)) != nil {
+		f.required = true
+	}
+	if len(c.after) > 0 {
+		merge(s, omitEmpty(t, c.after))
+	}
+	f.schema = s
+	return f, nil
+}
+
+// elementSchema returns the schema of the array item or the object property value with the tags after dive
+func (g *generator) elementSchema(t reflect.Type, tags []string) (*Schema, errors.EdgeX) {
+	nullable := t.Kind() == reflect.Ptr || t.Kind() == reflect.Interface
+	if t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	c, err := g.constraints(t, tags)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if len(c.requiredWithout) > 0 || len(c.requiredUnless) > 0 {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the conditional tags are not supported for the elements", nil)
+	}
+	s, err := g.typeSchema(t, true)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	c.applyElements(s)
+	if nullable {
+		for _, constraint := range append(c.before, c.after...) {
+			merge(s, constraint)
+		}
+		if !c.required {
+			s = withNull(s)
+		}
+		return s, nil
+	}
+	if c.required {
+		if nz := nonZero(t); nz != nil {
+			merge(s, nz)
+		}
+	}
+	for _, constraint := range c.before {
+		merge(s, constraint)
+	}
+	if len(c.after) > 0 {
+		merge(s, omitEmpty(t, c.after))
+	}
+	return s, nil
+}
+
+// typeSchema returns the schema of the type without the constraints of the validation tags, except the ones of the
+// nested struct fields when constrained
+func (g *generator) typeSchema(t reflect.Type, constrained bool) (*Schema, errors.EdgeX) {
+	switch t.Kind() {
+	case reflect.Ptr:
+		return g.typeSchema(t.Elem(), constrained)
+	case reflect.Interface:
+		return &Schema{}, nil
+	case reflect.String:
+		return &Schema{Type: Types{TypeString}}, nil
+	case reflect.Bool:
+		return &Schema{Type: Types{TypeBoolean}}, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return &Schema{Type: Types{TypeInteger}}, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return &Schema{Type: Types{TypeInteger}, Minimum: float(0)}, nil
+	case reflect.Float32, reflect.Float64:
+		return &Schema{Type: Types{TypeNumber}}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			// encoding/json encodes []byte as the base64 string
+			return &Schema{Type: Types{TypeString}, ContentEncoding: "base64"}, nil
+		}
+		// The validator doesn't validate the elements without dive
+		items, err := g.typeSchema(t.Elem(), false)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		return &Schema{Type: Types{TypeArray}, Items: items}, nil
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the map key type %v is not supported", t.Key()), nil)
+		}
+		values, err := g.typeSchema(t.Elem(), false)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		return &Schema{Type: Types{TypeObject}, AdditionalProperties: values}, nil
+	case reflect.Struct:
+		return g.structSchema(t, constrained)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the type %v is not supported", t), nil)
+	}
+}
+
+// constraints parses the validation tags of the value of the type
+func (g *generator) constraints(t reflect.Type, tags []string) (*constraints, errors.EdgeX) {
+	c := &constraints{}
+	if len(tags) > 0 {
+		c.first, _ = splitTag(tags[0])
+	}
+	for i := 0; i < len(tags); i++ {
+		name, param := splitTag(tags[i])
+		switch name {
+		case tagOmitEmpty:
+			c.omitEmpty = true
+			continue
+		case tagRequired:
+			c.required = true
+			continue
+		case tagRequiredWithout:
+			c.requiredWithout = append(c.requiredWithout, param)
+			continue
+		case tagRequiredUnless:
+			c.requiredUnless = strings.Fields(param)
+			if len(c.requiredUnless)%2 != 0 {
+				return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s parameter %s", tagRequiredUnless, param), nil)
+			}
+			continue
+		case tagDive:
+			return c, g.dive(c, t, tags[i+1:])
+		}
+		if t.Kind() == reflect.Struct {
+			// The validator only validates the fields of the struct
+			continue
+		}
+		s, err := translate(t, tags[i])
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		if c.omitEmpty {
+			c.after = append(c.after, s)
+		} else {
+			c.before = append(c.before, s)
+		}
+	}
+	return c, nil
+}
+
+func (g *generator) dive(c *constraints, t reflect.Type, tags []string) errors.EdgeX {
+	var err errors.EdgeX
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		c.items, err = g.elementSchema(t.Elem(), tags)
+	case reflect.Map:
+		if len(tags) > 0 && tags[0] == tagKeys {
+			end := indexOf(tags, tagEndKeys)
+			if end < 0 {
+				return errors.NewCommonEdgeX(errors.KindContractInvalid, "keys without endkeys", nil)
+			}
+			if c.keys, err = g.elementSchema(t.Key(), tags[1:end]); err != nil {
+				return errors.NewCommonEdgeXWrapper(err)
+			}
+			tags = tags[end+1:]
+		}
+		c.values, err = g.elementSchema(t.Elem(), tags)
+	default:
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("dive is not supported for the type %v", t), nil)
+	}
+	return err
+}
+
+// applyElements sets the element schemas of the dive tag to the schema of the array or the object
+func (c *constraints) applyElements(s *Schema) {
+	if c.items != nil {
+		s.Items = c.items
+	}
+	if c.keys != nil {
+		c.keys.Type = nil
+		s.PropertyNames = c.keys
+	}
+	if c.values != nil {
+		s.AdditionalProperties = c.values
+	}
+}
+
+// translate returns the schema of the validation tag, where the alternatives separated by "|" become anyOf
+func translate(t reflect.Type, tag string) (*Schema, errors.EdgeX) {
+	alternatives := strings.Split(tag, "|")
+	if len(alternatives) > 1 {
+		s := &Schema{}
+		for _, alternative := range alternatives {
+			a, err := translate(t, alternative)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			s.AnyOf = append(s.AnyOf, a)
+		}
+		return s, nil
+	}
+
+	name, param := splitTag(tag)
+	switch name {
+	case "uuid":
+		return &Schema{Format: "uuid", Pattern: uuidPattern}, nil
+	case "uri":
+		return &Schema{Format: "uri"}, nil
+	case "email":
+		return &Schema{Format: "email"}, nil
+	case "edgex-dto-uuid":
+		return &Schema{Format: "uuid", Pattern: dtoUuidPattern}, nil
+	case "edgex-dto-none-empty-string":
+		return &Schema{Pattern: noneEmptyStringPattern}, nil
+	case "edgex-dto-rfc3986-unreserved-chars":
+		return &Schema{Pattern: rfc3986UnreservedChars}, nil
+	case "edgex-dto-duration":
+		return &Schema{Pattern: durationPattern}, nil
+	case "edgex-dto-interval-datetime":
+		return &Schema{Pattern: intervalDatetimePattern}, nil
+	case "edgex-dto-mqtt-publish-topic":
+		return &Schema{Pattern: mqttPublishTopicPattern}, nil
+	case "edgex-dto-http-header-name":
+		return &Schema{Pattern: httpHeaderNamePattern}, nil
+	case "edgex-dto-regex":
+		return &Schema{Format: "regex"}, nil
+	case "edgex-dto-value-type":
+		// The value types are case-insensitive, and the canonical ones are listed as the examples for the editors
+		valueTypes := v2.ValueTypes()
+		s := &Schema{Pattern: caseInsensitivePattern(valueTypes)}
+		for _, valueType := range valueTypes {
+			s.Examples = append(s.Examples, valueType)
+		}
+		return s, nil
+	case "oneof":
+		s := &Schema{}
+		for _, p := range oneOfParamRegex.FindAllString(param, -1) {
+			value, err := parseValue(t, strings.Trim(p, "'"))
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			s.Enum = append(s.Enum, value)
+		}
+		return s, nil
+	case "len", "min", "max", "gt", "gte", "lt", "lte":
+		return bound(t, name, param)
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the validation tag %s is not supported", tag), nil)
+	}
+}
+
+// bound translates the tags comparing the length of the strings, the number of the items or the properties, or the
+// numbers
+func bound(t reflect.Type, name string, param string) (*Schema, errors.EdgeX) {
+	n, err := strconv.ParseFloat(param, 64)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s parameter %s", name, param), err)
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		switch name {
+		case "len":
+			return &Schema{Minimum: float(n), Maximum: float(n)}, nil
+		case "min", "gte":
+			return &Schema{Minimum: float(n)}, nil
+		case "max", "lte":
+			return &Schema{Maximum: float(n)}, nil
+		case "gt":
+			return &Schema{ExclusiveMinimum: float(n)}, nil
+		default:
+			return &Schema{ExclusiveMaximum: float(n)}, nil
+		}
+	}
+
+	min, max := lengths(name, int(n))
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{MinLength: min, MaxLength: max}, nil
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not supported for the bytes", name), nil)
+		}
+		return &Schema{MinItems: min, MaxItems: max}, nil
+	case reflect.Map:
+		return &Schema{MinProperties: min, MaxProperties: max}, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not supported for the type %v", name, t), nil)
+	}
+}
+
+// lengths returns the inclusive minimum and maximum of the length tags
+func lengths(name string, n int) (*int, *int) {
+	switch name {
+	case "len":
+		return &n, &n
+	case "min", "gte":
+		return &n, nil
+	case "max", "lte":
+		return nil, &n
+	case "gt":
+		n++
+		return &n, nil
+	default:
+		n--
+		return nil, &n
+	}
+}
+
+// nonZero returns the schema of the values which aren't the zero value of the type, or nil if it's not applicable
+func nonZero(t reflect.Type) *Schema {
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{MinLength: intPtr(1)}
+	case reflect.Bool:
+		return &Schema{Const: true}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return &Schema{Not: &Schema{Const: 0}}
+	case reflect.Slice, reflect.Array, reflect.Map:
+		// The missing property is nil, while any present value isn't
+		return &Schema{}
+	default:
+		return nil
+	}
+}
+
+// omitEmpty returns the schema which applies the constraints unless the value is the zero value of the type.
+// The arrays and the objects are not nil when present, so the constraints always apply to them.
+func omitEmpty(t reflect.Type, constraints []*Schema) *Schema {
+	s := &Schema{}
+	for _, constraint := range constraints {
+		merge(s, constraint)
+	}
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{AnyOf: []*Schema{{Const: ""}, s}}
+	case reflect.Bool:
+		return &Schema{AnyOf: []*Schema{{Const: false}, s}}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return &Schema{AnyOf: []*Schema{{Const: 0}, s}}
+	default:
+		return s
+	}
+}
+
+// presence returns the schema of the object which has the field with a non-zero value
+func presence(f *field) *Schema {
+	value := f.nonZero
+	if value == nil {
+		value = &Schema{}
+	}
+	return &Schema{Required: []string{f.name}, Properties: map[string]*Schema{f.name: value}}
+}
+
+// zeroJSON returns the JSON of the zero value of the type which isn't a pointer
+func zeroJSON(t reflect.Type) []byte {
+	switch t.Kind() {
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return []byte(`""`)
+		}
+		return []byte("[]")
+	case reflect.Map:
+		return []byte("{}")
+	case reflect.Struct:
+		// The zero values of the fields are the same as the missing properties
+		return []byte("{}")
+	default:
+		data, _ := json.Marshal(reflect.Zero(t).Interface())
+		return data
+	}
+}
+
+// withNull returns the schema which accepts null as well
+func withNull(s *Schema) *Schema {
+	if len(s.AllOf) > 0 || len(s.AnyOf) > 0 || s.Not != nil || s.Const != nil {
+		return &Schema{AnyOf: []*Schema{{Type: Types{TypeNull}}, s}}
+	}
+	if len(s.Type) > 0 {
+		s.Type = append(s.Type, TypeNull)
+	}
+	if s.Enum != nil {
+		s.Enum = append(s.Enum, nil)
+	}
+	return s
+}
+
+// merge adds the keywords of src to dst, where the keywords which dst has already are added as allOf
+func merge(dst, src *Schema) {
+	d := reflect.ValueOf(dst).Elem()
+	s := reflect.ValueOf(src).Elem()
+	conflicted := false
+	for i := 0; i < s.NumField(); i++ {
+		if s.Field(i).IsZero() {
+			continue
+		}
+		if d.Field(i).IsZero() {
+			d.Field(i).Set(s.Field(i))
+		} else {
+			conflicted = true
+		}
+	}
+	if conflicted {
+		dst.AllOf = append(dst.AllOf, src)
+	}
+}
+
+// appendRule appends the rule unless there is an identical one
+func appendRule(rules []*Schema, rule *Schema) []*Schema {
+	data, _ := json.Marshal(rule)
+	for _, r := range rules {
+		existing, _ := json.Marshal(r)
+		if string(existing) == string(data) {
+			return rules
+		}
+	}
+	return append(rules, rule)
+}
+
+// parseValue parses the tag parameter as the value of the type
+func parseValue(t reflect.Type, param string) (interface{}, errors.EdgeX) {
+	switch t.Kind() {
+	case reflect.String:
+		return param, nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value, err := strconv.ParseInt(param, 10, 64)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid integer parameter %s", param), err)
+		}
+		return value, nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value, err := strconv.ParseUint(param, 10, 64)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid unsigned integer parameter %s", param), err)
+		}
+		return value, nil
+	case reflect.Float32, reflect.Float64:
+		value, err := strconv.ParseFloat(param, 64)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid number parameter %s", param), err)
+		}
+		return value, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the parameter of the type %v is not supported", t), nil)
+	}
+}
+
+// jsonName returns the property name of the struct field, which is empty for the embedded struct without the name,
+// and false if the field is not marshaled
+func jsonName(sf reflect.StructField) (string, bool) {
+	if sf.PkgPath != "" && !sf.Anonymous {
+		return "", false
+	}
+	tag := sf.Tag.Get("json")
+	if tag == "-" {
+		return "", false
+	}
+	name := strings.Split(tag, ",")[0]
+	if name == "" && !sf.Anonymous {
+		name = sf.Name
+	}
+	return name, true
+}
+
+// caseInsensitivePattern returns the pattern matching any of the words regardless of the case
+func caseInsensitivePattern(words []string) string {
+	alternatives := make([]string, len(words))
+	for i, word := range words {
+		var b strings.Builder
+		for _, r := range word {
+			if unicode.IsLetter(r) {
+				b.WriteString("[" + string(unicode.ToUpper(r)) + string(unicode.ToLower(r)) + "]")
+			} else {
+				b.WriteRune(r)
+			}
+		}
+		alternatives[i] = b.String()
+	}
+	return "^(" + strings.Join(alternatives, "|") + ")$"
+}
+
+func splitTags(tag string) []string {
+	if tag == "" {
+		return nil
+	}
+	return strings.Split(tag, ",")
+}
+
+func splitTag(tag string) (string, string) {
+	parts := strings.SplitN(tag, "=", 2)
+	if len(parts) == 1 {
+		return parts[0], ""
+	}
+	return parts[0], parts[1]
+}
+
+func indexOf(tags []string, tag string) int {
+	for i, t := range tags {
+		if t == tag {
+			return i
+		}
+	}
+	return -1
+}
+
+func float(f float64) *float64 {
+	return &f
+}
+
+func intPtr(i int) *int {
+	return &i
+}
@@ -0,0 +1,147 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+type testEmbedded struct {
+	Version string `json:"version" validate:"required"`
+	Hidden  string `json:"name"`
+}
+
+type testDTO struct {
+	testEmbedded `json:",inline"`
+	Name         string            `json:"name" validate:"required,edgex-dto-rfc3986-unreserved-chars"`
+	Id           string            `json:"id,omitempty" validate:"omitempty,uuid"`
+	State        string            `json:"state" validate:"oneof='LOCKED' 'UNLOCKED'"`
+	Mode         *string           `json:"mode,omitempty" validate:"omitempty,oneof=a b"`
+	Progress     int               `json:"progress" validate:"min=0,max=100"`
+	Count        uint8             `json:"count" validate:"gt=2"`
+	Code         string            `json:"code" validate:"len=0|len=3"`
+	Labels       []string          `json:"labels,omitempty" validate:"omitempty,gt=0,dive,required"`
+	Options      map[string]string `json:"options,omitempty" validate:"omitempty,dive,keys,required,endkeys,edgex-dto-regex"`
+	Get          bool              `json:"get,omitempty" validate:"required_without=Set"`
+	Set          bool              `json:"set,omitempty" validate:"required_without=Get"`
+	Skipped      string            `json:"-" validate:"required"`
+	Nested       dtos.AutoEvent    `json:"nested"`
+	Optional     dtos.AutoEvent    `json:"optional" validate:"-"`
+}
+
+func TestGenerate(t *testing.T) {
+	s, err := Generate(&testDTO{})
+	require.NoError(t, err)
+	assert.Equal(t, Draft, s.Schema)
+	assert.Equal(t, "testDTO", s.Title)
+	assert.Equal(t, Types{TypeObject}, s.Type)
+	assert.Equal(t, []string{"version", "name", "state", "count", "nested"}, s.Required)
+	assert.NotContains(t, s.Properties, "Skipped")
+
+	tests := []struct {
+		property string
+		expected string
+	}{
+		{"version", `{"type":"string","minLength":1}`},
+		{"name", `{"type":"string","pattern":"^[a-zA-Z0-9\\-_.~]+$","minLength":1}`},
+		{"id", `{"type":"string","anyOf":[{"const":""},{"format":"uuid","pattern":"` + uuidPattern + `"}]}`},
+		{"state", `{"type":"string","enum":["LOCKED","UNLOCKED"]}`},
+		{"mode", `{"type":["string","null"],"enum":["a","b",null]}`},
+		{"progress", `{"type":"integer","minimum":0,"maximum":100}`},
+		{"count", `{"type":"integer","minimum":0,"exclusiveMinimum":2}`},
+		{"code", `{"type":"string","anyOf":[{"minLength":0,"maxLength":0},{"minLength":3,"maxLength":3}]}`},
+		{"labels", `{"type":"array","items":{"type":"string","minLength":1},"minItems":1}`},
+		{"options", `{"type":"object","additionalProperties":{"type":"string","format":"regex"},"propertyNames":{"minLength":1}}`},
+		{"optional", `{"type":"object","properties":{"interval":{"type":"string"},"onChange":{"type":"boolean"},"sourceName":{"type":"string"}}}`},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.property, func(t *testing.T) {
+			actual, err := json.Marshal(s.Properties[testCase.property])
+			require.NoError(t, err)
+			assert.JSONEq(t, testCase.expected, string(actual))
+		})
+	}
+
+	rules, jsonErr := json.Marshal(s.AllOf)
+	require.NoError(t, jsonErr)
+	assert.JSONEq(t, `[{"anyOf":[
+		{"required":["get"],"properties":{"get":{"const":true}}},
+		{"required":["set"],"properties":{"set":{"const":true}}}
+	]}]`, string(rules))
+}
+
+func TestGenerate_UnsupportedTag(t *testing.T) {
+	_, err := Generate(struct {
+		Name string `json:"name" validate:"alphanum"`
+	}{})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+	assert.Contains(t, err.Error(), "the validation tag alphanum is not supported")
+
+	_, err = Generate("not a struct")
+	require.Error(t, err)
+}
+
+func TestGenerate_Conditional(t *testing.T) {
+	s, err := Generate(requests.UpdateDeviceRequest{})
+	require.NoError(t, err)
+	device := s.Properties["device"]
+	require.NotNil(t, device)
+	assert.Equal(t, []string{"apiVersion", "device"}, s.Required)
+	assert.Empty(t, device.Required)
+	rules, jsonErr := json.Marshal(device.AllOf)
+	require.NoError(t, jsonErr)
+	assert.JSONEq(t, `[{"anyOf":[
+		{"required":["id"],"properties":{"id":{"not":{"type":"null"}}}},
+		{"required":["name"],"properties":{"name":{"not":{"type":"null"}}}}
+	]}]`, string(rules))
+
+	address, err := Generate(dtos.Address{})
+	require.NoError(t, err)
+	rules, jsonErr = json.Marshal(address.AllOf)
+	require.NoError(t, jsonErr)
+	assert.JSONEq(t, `[
+		{"anyOf":[{"required":["host"],"properties":{"host":{"minLength":1}}},{"required":["type"],"properties":{"type":{"const":"EMAIL"}}}]},
+		{"anyOf":[{"required":["port"],"properties":{"port":{"not":{"const":0}}}},{"required":["type"],"properties":{"type":{"const":"EMAIL"}}}]}
+	]`, string(rules))
+}
+
+func TestDocuments(t *testing.T) {
+	documents, err := Documents()
+	require.NoError(t, err)
+	assert.Len(t, documents, len(documentDTOs))
+	for _, name := range []string{"DeviceProfile", "AddDeviceRequest", "UpdateSubscriptionRequest", "MultiEventsResponse", "SecretRequest"} {
+		require.Contains(t, documents, name)
+		assert.Equal(t, name, documents[name].Title)
+	}
+
+	data, jsonErr := json.Marshal(documents["AddDeviceRequest"])
+	require.NoError(t, jsonErr)
+	var decoded Schema
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, Types{TypeObject}, decoded.Type)
+	assert.Equal(t, []string{"apiVersion", "device"}, decoded.Required)
+}
+
+func TestGenerate_NotificationEnums(t *testing.T) {
+	s, err := Generate(dtos.Notification{})
+	require.NoError(t, err)
+	require.Contains(t, s.Properties, "severity")
+	require.Contains(t, s.Properties, "status")
+	assert.Equal(t, []interface{}{models.Minor, models.Normal, models.Critical}, s.Properties["severity"].Enum)
+	status, jsonErr := json.Marshal(s.Properties["status"])
+	require.NoError(t, jsonErr)
+	assert.JSONEq(t, `{"type":"string","anyOf":[{"const":""},{"enum":["NEW","PROCESSED","ESCALATED"]}]}`, string(status))
+}
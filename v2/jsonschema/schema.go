@@ -0,0 +1,98 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package jsonschema generates the JSON Schema documents of the v2 DTOs. The constraints of the schemas are translated
+// from the validation tags of the DTOs, so that the tools like the profile editors and the form UIs can check the
+// payloads the same way as v2.Validate does.
+package jsonschema
+
+import (
+	"encoding/json"
+)
+
+// Draft is the JSON Schema dialect of the generated documents
+const Draft = "https://json-schema.org/draft/2020-12/schema"
+
+// Constants for the JSON Schema types
+const (
+	TypeNull    = "null"
+	TypeBoolean = "boolean"
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeNumber  = "number"
+	TypeInteger = "integer"
+	TypeString  = "string"
+)
+
+// Schema is a JSON Schema, supporting the keywords needed to describe the DTOs
+type Schema struct {
+	Schema          string        `json:"$schema,omitempty"`
+	Title           string        `json:"title,omitempty"`
+	Type            Types         `json:"type,omitempty"`
+	Format          string        `json:"format,omitempty"`
+	ContentEncoding string        `json:"contentEncoding,omitempty"`
+	Enum            []interface{} `json:"enum,omitempty"`
+	Const           interface{}   `json:"const,omitempty"`
+	Examples        []interface{} `json:"examples,omitempty"`
+
+	Pattern   string `json:"pattern,omitempty"`
+	MinLength *int   `json:"minLength,omitempty"`
+	MaxLength *int   `json:"maxLength,omitempty"`
+
+	Minimum          *float64 `json:"minimum,omitempty"`
+	Maximum          *float64 `json:"maximum,omitempty"`
+	ExclusiveMinimum *float64 `json:"exclusiveMinimum,omitempty"`
+	ExclusiveMaximum *float64 `json:"exclusiveMaximum,omitempty"`
+
+	Items    *Schema `json:"items,omitempty"`
+	MinItems *int    `json:"minItems,omitempty"`
+	MaxItems *int    `json:"maxItems,omitempty"`
+
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+	PropertyNames        *Schema            `json:"propertyNames,omitempty"`
+	MinProperties        *int               `json:"minProperties,omitempty"`
+	MaxProperties        *int               `json:"maxProperties,omitempty"`
+
+	AllOf []*Schema `json:"allOf,omitempty"`
+	AnyOf []*Schema `json:"anyOf,omitempty"`
+	Not   *Schema   `json:"not,omitempty"`
+}
+
+// Types is the value of the type keyword, which is marshaled as a single string if it has only one type
+type Types []string
+
+// MarshalJSON implements the Marshaler interface for the Types type
+func (t Types) MarshalJSON() ([]byte, error) {
+	if len(t) == 1 {
+		return json.Marshal(t[0])
+	}
+	return json.Marshal([]string(t))
+}
+
+// UnmarshalJSON implements the Unmarshaler interface for the Types type
+func (t *Types) UnmarshalJSON(b []byte) error {
+	var single string
+	if err := json.Unmarshal(b, &single); err == nil {
+		*t = Types{single}
+		return nil
+	}
+	var types []string
+	if err := json.Unmarshal(b, &types); err != nil {
+		return err
+	}
+	*t = types
+	return nil
+}
+
+func (t Types) contains(name string) bool {
+	for _, n := range t {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
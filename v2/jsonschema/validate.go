@@ -0,0 +1,221 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// Validate checks whether the JSON document satisfies the Schema. Only the keywords of the Schema type are
+// supported, and format and the other annotations are not asserted.
+func (s *Schema) Validate(data []byte) errors.EdgeX {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	var value interface{}
+	if err := decoder.Decode(&value); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the JSON document", err)
+	}
+	if problems := s.validate(value, ""); len(problems) > 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, strings.Join(problems, "; "), nil)
+	}
+	return nil
+}
+
+// validate returns the problems of the value at the JSON Pointer path
+func (s *Schema) validate(value interface{}, path string) []string {
+	location := path
+	if location == "" {
+		location = "/"
+	}
+	if len(s.Type) > 0 && !s.Type.matches(value) {
+		return []string{fmt.Sprintf("%s should be %s", location, strings.Join(s.Type, " or "))}
+	}
+
+	var problems []string
+	if s.Enum != nil && !containsValue(s.Enum, value) {
+		problems = append(problems, fmt.Sprintf("%s should be one of %v", location, s.Enum))
+	}
+	if s.Const != nil && !equalValues(s.Const, value) {
+		problems = append(problems, fmt.Sprintf("%s should be %v", location, s.Const))
+	}
+
+	switch v := value.(type) {
+	case string:
+		problems = append(problems, s.validateString(v, location)...)
+	case json.Number:
+		problems = append(problems, s.validateNumber(v, location)...)
+	case []interface{}:
+		problems = append(problems, count(location, "items", len(v), s.MinItems, s.MaxItems)...)
+		if s.Items != nil {
+			for i, item := range v {
+				problems = append(problems, s.Items.validate(item, path+"/"+strconv.Itoa(i))...)
+			}
+		}
+	case map[string]interface{}:
+		problems = append(problems, s.validateObject(v, path, location)...)
+	}
+
+	for _, sub := range s.AllOf {
+		problems = append(problems, sub.validate(value, path)...)
+	}
+	if len(s.AnyOf) > 0 {
+		matched := false
+		var alternatives []string
+		for _, sub := range s.AnyOf {
+			p := sub.validate(value, path)
+			if len(p) == 0 {
+				matched = true
+				break
+			}
+			alternatives = append(alternatives, strings.Join(p, ", "))
+		}
+		if !matched {
+			problems = append(problems, fmt.Sprintf("%s should match any of (%s)", location, strings.Join(alternatives, ") or (")))
+		}
+	}
+	if s.Not != nil && len(s.Not.validate(value, path)) == 0 {
+		problems = append(problems, fmt.Sprintf("%s should not match the not schema", location))
+	}
+	return problems
+}
+
+func (s *Schema) validateString(v string, location string) []string {
+	problems := count(location, "characters", utf8.RuneCountInString(v), s.MinLength, s.MaxLength)
+	if s.Pattern != "" {
+		re, err := regexp.Compile(s.Pattern)
+		if err != nil {
+			problems = append(problems, fmt.Sprintf("%s has the invalid pattern %s", location, s.Pattern))
+		} else if !re.MatchString(v) {
+			problems = append(problems, fmt.Sprintf("%s should match the pattern %s", location, s.Pattern))
+		}
+	}
+	return problems
+}
+
+func (s *Schema) validateNumber(v json.Number, location string) []string {
+	f, err := v.Float64()
+	if err != nil {
+		return []string{fmt.Sprintf("%s has the invalid number %s", location, v)}
+	}
+	var problems []string
+	if s.Minimum != nil && f < *s.Minimum {
+		problems = append(problems, fmt.Sprintf("%s should not be less than %v", location, *s.Minimum))
+	}
+	if s.Maximum != nil && f > *s.Maximum {
+		problems = append(problems, fmt.Sprintf("%s should not be greater than %v", location, *s.Maximum))
+	}
+	if s.ExclusiveMinimum != nil && f <= *s.ExclusiveMinimum {
+		problems = append(problems, fmt.Sprintf("%s should be greater than %v", location, *s.ExclusiveMinimum))
+	}
+	if s.ExclusiveMaximum != nil && f >= *s.ExclusiveMaximum {
+		problems = append(problems, fmt.Sprintf("%s should be less than %v", location, *s.ExclusiveMaximum))
+	}
+	return problems
+}
+
+func (s *Schema) validateObject(v map[string]interface{}, path string, location string) []string {
+	problems := count(location, "properties", len(v), s.MinProperties, s.MaxProperties)
+	for _, name := range s.Required {
+		if _, ok := v[name]; !ok {
+			problems = append(problems, fmt.Sprintf("%s should have the property %s", location, name))
+		}
+	}
+	names := make([]string, 0, len(v))
+	for name := range v {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		propertyPath := path + "/" + escapePointer(name)
+		if s.PropertyNames != nil {
+			problems = append(problems, s.PropertyNames.validate(name, propertyPath)...)
+		}
+		if property, ok := s.Properties[name]; ok {
+			problems = append(problems, property.validate(v[name], propertyPath)...)
+		} else if s.AdditionalProperties != nil {
+			problems = append(problems, s.AdditionalProperties.validate(v[name], propertyPath)...)
+		}
+	}
+	return problems
+}
+
+// matches checks whether the decoded JSON value is one of the types, where an integer is a number without the
+// fraction and exponent parts as encoding/json requires
+func (t Types) matches(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return t.contains(TypeNull)
+	case bool:
+		return t.contains(TypeBoolean)
+	case string:
+		return t.contains(TypeString)
+	case json.Number:
+		return t.contains(TypeNumber) || (t.contains(TypeInteger) && !strings.ContainsAny(v.String(), ".eE"))
+	case []interface{}:
+		return t.contains(TypeArray)
+	case map[string]interface{}:
+		return t.contains(TypeObject)
+	default:
+		return false
+	}
+}
+
+func count(location string, unit string, n int, min *int, max *int) []string {
+	var problems []string
+	if min != nil && n < *min {
+		problems = append(problems, fmt.Sprintf("%s should have at least %d %s", location, *min, unit))
+	}
+	if max != nil && n > *max {
+		problems = append(problems, fmt.Sprintf("%s should have at most %d %s", location, *max, unit))
+	}
+	return problems
+}
+
+func containsValue(values []interface{}, value interface{}) bool {
+	for _, v := range values {
+		if equalValues(v, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// equalValues compares the schema value with the decoded JSON value, where the numbers are compared by their values
+func equalValues(expected interface{}, actual interface{}) bool {
+	if number, ok := actual.(json.Number); ok {
+		f, err := number.Float64()
+		if err != nil {
+			return false
+		}
+		e := reflect.ValueOf(expected)
+		switch e.Kind() {
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+			return float64(e.Int()) == f
+		case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			return float64(e.Uint()) == f
+		case reflect.Float32, reflect.Float64:
+			return e.Float() == f
+		default:
+			return false
+		}
+	}
+	return reflect.DeepEqual(expected, actual)
+}
+
+// escapePointer escapes the property name as a JSON Pointer reference token
+func escapePointer(name string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(name, "~", "~0"), "/", "~1")
+}
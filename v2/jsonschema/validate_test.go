@@ -0,0 +1,170 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package jsonschema
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+)
+
+const testUUID = "82eb2e26-0f24-48aa-ae4c-de9dac3fb9bc"
+
+// TestValidate_AgreesWithValidator checks that the payloads are accepted by the schema if and only if they are
+// accepted by v2.Validate
+func TestValidate_AgreesWithValidator(t *testing.T) {
+	tests := []struct {
+		name    string
+		dto     interface{}
+		payload string
+		valid   bool
+	}{
+		{"valid device", requests.AddDeviceRequest{},
+			`{"apiVersion":"v2","device":{"name":"meter-1","adminState":"LOCKED","operatingState":"UP","serviceName":"modbus","profileName":"meter",
+			"protocols":{"modbus-tcp":{"Address":"10.0.0.1"}},"autoEvents":[{"interval":"1.5s","sourceName":"voltage"}]}}`, true},
+		{"device with request id", requests.AddDeviceRequest{},
+			`{"apiVersion":"v2","requestId":"` + testUUID + `","device":{"name":"meter-1","adminState":"LOCKED","operatingState":"UP","serviceName":"modbus","profileName":"meter","protocols":{"p":{}}}}`, true},
+		{"invalid request id", requests.AddDeviceRequest{},
+			`{"apiVersion":"v2","requestId":"1234","device":{"name":"meter-1","adminState":"LOCKED","operatingState":"UP","serviceName":"modbus","profileName":"meter","protocols":{"p":{}}}}`, false},
+		{"missing device", requests.AddDeviceRequest{}, `{"apiVersion":"v2"}`, false},
+		{"missing api version", requests.AddDeviceRequest{},
+			`{"device":{"name":"meter-1","adminState":"LOCKED","operatingState":"UP","serviceName":"modbus","profileName":"meter","protocols":{"p":{}}}}`, false},
+		{"reserved chars in name", requests.AddDeviceRequest{},
+			`{"apiVersion":"v2","device":{"name":"meter 1","adminState":"LOCKED","operatingState":"UP","serviceName":"modbus","profileName":"meter","protocols":{"p":{}}}}`, false},
+		{"invalid admin state", requests.AddDeviceRequest{},
+			`{"apiVersion":"v2","device":{"name":"meter-1","adminState":"locked","operatingState":"UP","serviceName":"modbus","profileName":"meter","protocols":{"p":{}}}}`, false},
+		{"empty protocols", requests.AddDeviceRequest{},
+			`{"apiVersion":"v2","device":{"name":"meter-1","adminState":"LOCKED","operatingState":"UP","serviceName":"modbus","profileName":"meter","protocols":{}}}`, false},
+		{"invalid auto event interval", requests.AddDeviceRequest{},
+			`{"apiVersion":"v2","device":{"name":"meter-1","adminState":"LOCKED","operatingState":"UP","serviceName":"modbus","profileName":"meter",
+			"protocols":{"p":{}},"autoEvents":[{"interval":"1 second","sourceName":"voltage"}]}}`, false},
+
+		{"update device by name", requests.UpdateDeviceRequest{}, `{"apiVersion":"v2","device":{"name":"meter-1","adminState":"UNLOCKED"}}`, true},
+		{"update device by id", requests.UpdateDeviceRequest{}, `{"apiVersion":"v2","device":{"id":"` + testUUID + `"}}`, true},
+		{"update device without id and name", requests.UpdateDeviceRequest{}, `{"apiVersion":"v2","device":{"adminState":"UNLOCKED"}}`, false},
+		{"update device with null name", requests.UpdateDeviceRequest{}, `{"apiVersion":"v2","device":{"name":null}}`, false},
+		{"update device with null admin state", requests.UpdateDeviceRequest{}, `{"apiVersion":"v2","device":{"name":"meter-1","adminState":null}}`, true},
+		{"update device with invalid id", requests.UpdateDeviceRequest{}, `{"apiVersion":"v2","device":{"id":"meter-1"}}`, false},
+		{"update device with blank description", requests.UpdateDeviceRequest{}, `{"apiVersion":"v2","device":{"name":"meter-1","description":" "}}`, false},
+
+		{"valid interval", requests.AddIntervalRequest{},
+			`{"apiVersion":"v2","interval":{"name":"hourly","start":"20210101T000000","interval":"1h"}}`, true},
+		{"invalid interval start", requests.AddIntervalRequest{},
+			`{"apiVersion":"v2","interval":{"name":"hourly","start":"20211301T000000","interval":"1h"}}`, false},
+		{"missing interval name", requests.AddIntervalRequest{}, `{"apiVersion":"v2","interval":{"interval":"1h"}}`, false},
+
+		{"valid subscription", requests.AddSubscriptionRequest{},
+			`{"apiVersion":"v2","subscription":{"name":"alerts","receiver":"ops","adminState":"UNLOCKED","categories":["health"],
+			"channels":[{"type":"EMAIL","recipients":["ops@example.com"]},{"type":"REST","host":"localhost","port":8080,"httpMethod":"POST"}]}}`, true},
+		{"subscription without categories and labels", requests.AddSubscriptionRequest{},
+			`{"apiVersion":"v2","subscription":{"name":"alerts","receiver":"ops","adminState":"UNLOCKED","channels":[{"type":"EMAIL"}]}}`, false},
+		{"subscription with empty categories", requests.AddSubscriptionRequest{},
+			`{"apiVersion":"v2","subscription":{"name":"alerts","receiver":"ops","adminState":"UNLOCKED","categories":[],"labels":["l"],"channels":[{"type":"EMAIL"}]}}`, false},
+		{"rest channel without host", requests.AddSubscriptionRequest{},
+			`{"apiVersion":"v2","subscription":{"name":"alerts","receiver":"ops","adminState":"UNLOCKED","labels":["l"],"channels":[{"type":"REST","port":8080}]}}`, false},
+		{"subscription without channels", requests.AddSubscriptionRequest{},
+			`{"apiVersion":"v2","subscription":{"name":"alerts","receiver":"ops","adminState":"UNLOCKED","labels":["l"],"channels":[]}}`, false},
+
+		{"valid provision watcher", requests.AddProvisionWatcherRequest{},
+			`{"apiVersion":"v2","provisionWatcher":{"name":"pw","adminState":"UNLOCKED","serviceName":"modbus","profileName":"meter",
+			"identifiers":{"address":"10\\..*"},"blockingIdentifiers":{"port":["502"]}}}`, true},
+		{"empty identifiers", requests.AddProvisionWatcherRequest{},
+			`{"apiVersion":"v2","provisionWatcher":{"name":"pw","adminState":"UNLOCKED","serviceName":"modbus","profileName":"meter","identifiers":{}}}`, false},
+		{"empty blocking identifier values", requests.AddProvisionWatcherRequest{},
+			`{"apiVersion":"v2","provisionWatcher":{"name":"pw","adminState":"UNLOCKED","serviceName":"modbus","profileName":"meter",
+			"identifiers":{"address":"x"},"blockingIdentifiers":{"port":[]}}}`, false},
+
+		{"valid profile", dtos.DeviceProfile{},
+			`{"name":"meter","deviceResources":[{"name":"voltage","properties":{"valueType":"float32","readWrite":"R"}}]}`, true},
+		{"profile with invalid value type", dtos.DeviceProfile{},
+			`{"name":"meter","deviceResources":[{"name":"voltage","properties":{"valueType":"double","readWrite":"R"}}]}`, false},
+		{"profile without resources", dtos.DeviceProfile{}, `{"name":"meter","deviceResources":[]}`, false},
+
+		{"valid secret", common.SecretRequest{},
+			`{"apiVersion":"v2","path":"mqtt","secretData":[{"key":"username","value":"admin"}]}`, true},
+		{"secret without value", common.SecretRequest{},
+			`{"apiVersion":"v2","path":"mqtt","secretData":[{"key":"username"}]}`, false},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			s, err := Generate(testCase.dto)
+			require.NoError(t, err)
+
+			schemaErr := s.Validate([]byte(testCase.payload))
+			validatorErr := validateWithValidator(testCase.dto, testCase.payload)
+			if testCase.valid {
+				assert.NoError(t, schemaErr, "schema")
+				assert.NoError(t, validatorErr, "validator")
+			} else {
+				assert.Error(t, schemaErr, "schema")
+				assert.Error(t, validatorErr, "validator")
+			}
+		})
+	}
+}
+
+// validateWithValidator decodes the payload into a new DTO of the same type and validates it with v2.Validate
+func validateWithValidator(dto interface{}, payload string) error {
+	value := reflect.New(reflect.TypeOf(dto))
+	if err := json.Unmarshal([]byte(payload), value.Interface()); err != nil {
+		return err
+	}
+	return v2.Validate(value.Elem().Interface())
+}
+
+func TestValidate_Keywords(t *testing.T) {
+	s := &Schema{
+		Type:     Types{TypeObject},
+		Required: []string{"name"},
+		Properties: map[string]*Schema{
+			"name":  {Type: Types{TypeString}, Pattern: "^[a-z]+$", MaxLength: intPtr(5)},
+			"count": {Type: Types{TypeInteger}, Minimum: float(1)},
+			"tags":  {Type: Types{TypeArray}, Items: &Schema{Enum: []interface{}{"a", "b"}}, MaxItems: intPtr(2)},
+		},
+		AdditionalProperties: &Schema{Type: Types{TypeBoolean}},
+	}
+	tests := []struct {
+		name     string
+		payload  string
+		problems string
+	}{
+		{"valid", `{"name":"abc","count":2,"tags":["a"],"flag":true}`, ""},
+		{"missing name", `{}`, "/ should have the property name"},
+		{"pattern", `{"name":"ABC"}`, "/name should match the pattern ^[a-z]+$"},
+		{"max length", `{"name":"abcdef"}`, "/name should have at most 5 characters"},
+		{"integer", `{"name":"a","count":1.5}`, "/count should be integer"},
+		{"minimum", `{"name":"a","count":0}`, "/count should not be less than 1"},
+		{"enum", `{"name":"a","tags":["c"]}`, "/tags/0 should be one of [a b]"},
+		{"max items", `{"name":"a","tags":["a","b","a"]}`, "/tags should have at most 2 items"},
+		{"additional property", `{"name":"a","flag":"yes"}`, "/flag should be boolean"},
+		{"not an object", `[]`, "/ should be object"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := s.Validate([]byte(testCase.payload))
+			if testCase.problems == "" {
+				assert.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Contains(t, err.Error(), testCase.problems)
+		})
+	}
+
+	err := s.Validate([]byte("{"))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
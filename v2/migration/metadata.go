@@ -0,0 +1,369 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	v1 "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+const (
+	readWriteR  = "R"
+	readWriteW  = "W"
+	readWriteRW = "RW"
+)
+
+// Device converts the v1 Device, whose Service and Profile are referred to by name in v2
+func (m *Migrator) Device(d v1.Device) (dtos.Device, errors.EdgeX) {
+	adminState, err := adminState(d.AdminState)
+	if err != nil {
+		return dtos.Device{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the device %s", d.Name), err)
+	}
+	operatingState, err := operatingState(d.OperatingState)
+	if err != nil {
+		return dtos.Device{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the device %s", d.Name), err)
+	}
+	protocols := make(map[string]dtos.ProtocolProperties, len(d.Protocols))
+	for name, properties := range d.Protocols {
+		protocols[name] = dtos.ProtocolProperties(properties)
+	}
+	return dtos.Device{
+		DBTimestamp:    dbTimestamp(d.Created, d.Modified),
+		Id:             m.id(EntityDevice, d.Name, d.Id),
+		Name:           d.Name,
+		Description:    d.Description,
+		AdminState:     adminState,
+		OperatingState: operatingState,
+		LastConnected:  d.LastConnected,
+		LastReported:   d.LastReported,
+		Labels:         d.Labels,
+		Location:       d.Location,
+		ServiceName:    d.Service.Name,
+		ProfileName:    d.Profile.Name,
+		AutoEvents:     autoEvents(d.AutoEvents),
+		Protocols:      protocols,
+	}, nil
+}
+
+// DeviceService converts the v1 DeviceService, whose Addressable is reduced to the BaseAddress in v2
+func (m *Migrator) DeviceService(ds v1.DeviceService) (dtos.DeviceService, errors.EdgeX) {
+	adminState, err := adminState(ds.AdminState)
+	if err != nil {
+		return dtos.DeviceService{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the device service %s", ds.Name), err)
+	}
+	a := ds.Addressable
+	if a.Protocol == "" || a.Address == "" {
+		return dtos.DeviceService{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("failed to migrate the device service %s, the addressable should have both the protocol and the address", ds.Name), nil)
+	}
+	const reason = "is not part of the BaseAddress"
+	m.lossyIfSet(EntityDeviceService, ds.Name, "addressable.path", a.Path, reason)
+	m.lossyIfSet(EntityDeviceService, ds.Name, "addressable.method", a.HTTPMethod, reason)
+	m.lossyIfSet(EntityDeviceService, ds.Name, "addressable.publisher", a.Publisher, reason)
+	m.lossyIfSet(EntityDeviceService, ds.Name, "addressable.topic", a.Topic, reason)
+	m.lossyIfSet(EntityDeviceService, ds.Name, "addressable.user", a.User, reason)
+	if a.Password != "" {
+		m.lossy(EntityDeviceService, ds.Name, "addressable.password", "***", reason)
+	}
+	if ds.OperatingState != "" && ds.OperatingState != v1.Enabled {
+		m.lossy(EntityDeviceService, ds.Name, "operatingState", ds.OperatingState, "is not supported by the v2 device service")
+	}
+	return dtos.DeviceService{
+		DBTimestamp:   dbTimestamp(ds.Created, ds.Modified),
+		Id:            m.id(EntityDeviceService, ds.Name, ds.Id),
+		Name:          ds.Name,
+		Description:   ds.Description,
+		LastConnected: ds.LastConnected,
+		LastReported:  ds.LastReported,
+		Labels:        ds.Labels,
+		BaseAddress:   baseAddress(a.Protocol, a.Address, a.Port),
+		AdminState:    adminState,
+	}, nil
+}
+
+// ProvisionWatcher converts the v1 ProvisionWatcher, whose Service and Profile are referred to by name in v2. The
+// deprecated OperatingState of the v1 ProvisionWatcher is dropped, and reported as lossy unless it's ENABLED.
+func (m *Migrator) ProvisionWatcher(pw v1.ProvisionWatcher) (dtos.ProvisionWatcher, errors.EdgeX) {
+	adminState, err := adminState(pw.AdminState)
+	if err != nil {
+		return dtos.ProvisionWatcher{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the provision watcher %s", pw.Name), err)
+	}
+	if pw.OperatingState != "" && pw.OperatingState != v1.Enabled {
+		m.lossy(EntityProvisionWatcher, pw.Name, "operatingState", pw.OperatingState, "is not supported by the v2 provision watcher")
+	}
+	return dtos.ProvisionWatcher{
+		DBTimestamp:         dbTimestamp(pw.Created, pw.Modified),
+		Id:                  m.id(EntityProvisionWatcher, pw.Name, pw.Id),
+		Name:                pw.Name,
+		Identifiers:         pw.Identifiers,
+		BlockingIdentifiers: pw.BlockingIdentifiers,
+		ProfileName:         pw.Profile.Name,
+		ServiceName:         pw.Service.Name,
+		AdminState:          adminState,
+	}, nil
+}
+
+// DeviceProfileYAML converts the v1 device profile file content
+func (m *Migrator) DeviceProfileYAML(data []byte) (dtos.DeviceProfile, errors.EdgeX) {
+	var p v1.DeviceProfile
+	if err := yaml.Unmarshal(data, &p); err != nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal the v1 device profile", err)
+	}
+	return m.DeviceProfile(p)
+}
+
+// DeviceProfile converts the v1 DeviceProfile. The v1 deviceCommands become the v2 DeviceCommands, whose get and
+// set operations are merged into the resourceOperations, and the v1 coreCommands which are neither a device command
+// nor a device resource become the v2 DeviceCommands of their put parameters, since the v2 core commands are derived
+// from the device commands and device resources.
+func (m *Migrator) DeviceProfile(p v1.DeviceProfile) (dtos.DeviceProfile, errors.EdgeX) {
+	resources := make([]dtos.DeviceResource, len(p.DeviceResources))
+	resourceNames := make(map[string]bool, len(p.DeviceResources))
+	for i, r := range p.DeviceResources {
+		resource, err := m.deviceResource(p.Name, r)
+		if err != nil {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the device profile %s", p.Name), err)
+		}
+		resources[i] = resource
+		resourceNames[r.Name] = true
+	}
+
+	var commands []dtos.DeviceCommand
+	commandNames := make(map[string]bool, len(p.DeviceCommands))
+	for _, c := range p.DeviceCommands {
+		command, ok := m.deviceCommand(p.Name, c)
+		if ok {
+			commands = append(commands, command)
+			commandNames[c.Name] = true
+		}
+	}
+	for _, c := range p.CoreCommands {
+		if commandNames[c.Name] || resourceNames[c.Name] {
+			continue
+		}
+		command, ok := m.coreCommand(p.Name, c, resourceNames)
+		if ok {
+			commands = append(commands, command)
+			commandNames[c.Name] = true
+		}
+	}
+
+	return dtos.DeviceProfile{
+		DBTimestamp:     dbTimestamp(p.Created, p.Modified),
+		Id:              m.id(EntityDeviceProfile, p.Name, p.Id),
+		Name:            p.Name,
+		Manufacturer:    p.Manufacturer,
+		Description:     p.Description,
+		Model:           p.Model,
+		Labels:          p.Labels,
+		DeviceResources: resources,
+		DeviceCommands:  commands,
+	}, nil
+}
+
+func (m *Migrator) deviceResource(profileName string, r v1.DeviceResource) (dtos.DeviceResource, errors.EdgeX) {
+	value := r.Properties.Value
+	valueType, err := v2.NormalizeValueType(value.Type)
+	if err != nil {
+		return dtos.DeviceResource{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device resource %s", r.Name), err)
+	}
+	field := fmt.Sprintf("deviceResources[%s].properties", r.Name)
+	readWrite := strings.ToUpper(value.ReadWrite)
+	if readWrite == "" {
+		readWrite = readWriteRW
+		m.lossy(EntityDeviceProfile, profileName, field+".value.readWrite", "", "is required in v2 and defaults to RW")
+	}
+	m.lossyIfSet(EntityDeviceProfile, profileName, field+".value.size", value.Size, "is not supported by v2")
+	m.lossyIfSet(EntityDeviceProfile, profileName, field+".value.precision", value.Precision, "is not supported by v2")
+	if value.FloatEncoding != "" && value.FloatEncoding != v1.ENotation {
+		m.lossy(EntityDeviceProfile, profileName, field+".value.floatEncoding", value.FloatEncoding, "is not supported by v2, the float values are always in eNotation")
+	}
+	m.lossyIfSet(EntityDeviceProfile, profileName, field+".units.type", r.Properties.Units.Type, "is not supported by v2, only the units defaultValue is kept")
+
+	var attributes map[string]interface{}
+	if r.Attributes != nil {
+		attributes = make(map[string]interface{}, len(r.Attributes))
+		for k, v := range r.Attributes {
+			attributes[k] = v
+		}
+	}
+	return dtos.DeviceResource{
+		Description: r.Description,
+		Name:        r.Name,
+		Tag:         r.Tag,
+		Properties: dtos.ResourceProperties{
+			ValueType:    valueType,
+			ReadWrite:    readWrite,
+			Units:        r.Properties.Units.DefaultValue,
+			Minimum:      value.Minimum,
+			Maximum:      value.Maximum,
+			DefaultValue: value.DefaultValue,
+			Mask:         value.Mask,
+			Shift:        value.Shift,
+			Scale:        value.Scale,
+			Offset:       value.Offset,
+			Base:         value.Base,
+			Assertion:    value.Assertion,
+			MediaType:    value.MediaType,
+		},
+		Attributes: attributes,
+	}, nil
+}
+
+// deviceCommand merges the get and set operations of the v1 deviceCommand, where a set operation of the same device
+// resource as a get operation only contributes its parameter as the default value
+func (m *Migrator) deviceCommand(profileName string, c v1.ProfileResource) (dtos.DeviceCommand, bool) {
+	field := fmt.Sprintf("deviceCommands[%s]", c.Name)
+	var operations []dtos.ResourceOperation
+	indexes := make(map[string]int)
+	for _, op := range c.Get {
+		indexes[deviceResourceOf(op)] = len(operations)
+		operations = append(operations, m.resourceOperation(profileName, field+".get", op))
+	}
+	merged := false
+	for _, op := range c.Set {
+		operation := m.resourceOperation(profileName, field+".set", op)
+		if i, ok := indexes[operation.DeviceResource]; ok {
+			if operations[i].DefaultValue == "" {
+				operations[i].DefaultValue = operation.DefaultValue
+			}
+			continue
+		}
+		if len(c.Get) > 0 {
+			merged = true
+		}
+		indexes[operation.DeviceResource] = len(operations)
+		operations = append(operations, operation)
+	}
+	if merged {
+		m.lossy(EntityDeviceProfile, profileName, field, "", "has different get and set operations, which are merged into a single list of resourceOperations")
+	}
+	if len(operations) == 0 {
+		m.lossy(EntityDeviceProfile, profileName, field, "", "has no operation and is dropped")
+		return dtos.DeviceCommand{}, false
+	}
+	return dtos.DeviceCommand{
+		Name:               c.Name,
+		ReadWrite:          readWriteOf(len(c.Get) > 0, len(c.Set) > 0),
+		ResourceOperations: operations,
+	}, true
+}
+
+// deviceResourceOf returns the device resource of the v1 operation, falling back to the deprecated Object field
+func deviceResourceOf(op v1.ResourceOperation) string {
+	if op.DeviceResource != "" {
+		return op.DeviceResource
+	}
+	return op.Object
+}
+
+func (m *Migrator) resourceOperation(profileName string, field string, op v1.ResourceOperation) dtos.ResourceOperation {
+	resource := deviceResourceOf(op)
+	field = fmt.Sprintf("%s[%s]", field, resource)
+	if len(op.Secondary) > 0 {
+		m.lossy(EntityDeviceProfile, profileName, field+".secondary", op.Secondary, "is not supported by v2")
+	}
+	deviceCommand := op.DeviceCommand
+	if deviceCommand == "" {
+		deviceCommand = op.Resource
+	}
+	m.lossyIfSet(EntityDeviceProfile, profileName, field+".deviceCommand", deviceCommand, "is not supported by v2, which can't nest the device commands")
+	return dtos.ResourceOperation{
+		DeviceResource: resource,
+		DefaultValue:   op.Parameter,
+		Mappings:       op.Mappings,
+	}
+}
+
+// coreCommand converts the v1 coreCommand into the DeviceCommand of its put parameters which are device resources
+func (m *Migrator) coreCommand(profileName string, c v1.Command, resourceNames map[string]bool) (dtos.DeviceCommand, bool) {
+	field := fmt.Sprintf("coreCommands[%s]", c.Name)
+	if len(c.Get.Responses) > 0 || len(c.Put.Responses) > 0 {
+		m.lossy(EntityDeviceProfile, profileName, field+".responses", "", "are not supported by v2")
+	}
+	var operations []dtos.ResourceOperation
+	for _, name := range c.Put.ParameterNames {
+		if resourceNames[name] {
+			operations = append(operations, dtos.ResourceOperation{DeviceResource: name})
+		}
+	}
+	if len(operations) == 0 {
+		m.lossy(EntityDeviceProfile, profileName, field, "", "refers to no device resource and is dropped")
+		return dtos.DeviceCommand{}, false
+	}
+	return dtos.DeviceCommand{
+		Name:               c.Name,
+		ReadWrite:          readWriteOf(c.Get.Path != "", c.Put.Path != ""),
+		ResourceOperations: operations,
+	}, true
+}
+
+func readWriteOf(read bool, write bool) string {
+	switch {
+	case read && write:
+		return readWriteRW
+	case write:
+		return readWriteW
+	default:
+		return readWriteR
+	}
+}
+
+func autoEvents(v1AutoEvents []v1.AutoEvent) []dtos.AutoEvent {
+	if v1AutoEvents == nil {
+		return nil
+	}
+	result := make([]dtos.AutoEvent, len(v1AutoEvents))
+	for i, ae := range v1AutoEvents {
+		result[i] = dtos.AutoEvent{Interval: ae.Frequency, OnChange: ae.OnChange, SourceName: ae.Resource}
+	}
+	return result
+}
+
+// adminState converts the v1 AdminState, which defaults to UNLOCKED
+func adminState(state v1.AdminState) (string, errors.EdgeX) {
+	switch strings.ToUpper(string(state)) {
+	case "", models.Unlocked:
+		return models.Unlocked, nil
+	case models.Locked:
+		return models.Locked, nil
+	default:
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid AdminState %s", state), nil)
+	}
+}
+
+// operatingState converts the v1 OperatingState ENABLED and DISABLED into UP and DOWN
+func operatingState(state v1.OperatingState) (string, errors.EdgeX) {
+	switch strings.ToUpper(string(state)) {
+	case "":
+		return models.Unknown, nil
+	case v1.Enabled, models.Up:
+		return models.Up, nil
+	case v1.Disabled, models.Down:
+		return models.Down, nil
+	default:
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid OperatingState %s", state), nil)
+	}
+}
+
+// baseAddress renders the URL of the protocol, address and port with Address.URL, where the http protocol is
+// rendered as a RESTAddress and any other protocol as a WebhookAddress of that scheme
+func baseAddress(protocol string, address string, port int) string {
+	base := models.BaseAddress{Host: address, Port: port}
+	scheme := strings.ToLower(protocol)
+	if scheme == v2.SchemeHTTP {
+		return models.RESTAddress{BaseAddress: base}.URL()
+	}
+	return models.WebhookAddress{BaseAddress: base, Scheme: scheme}.URL()
+}
@@ -0,0 +1,262 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	v1 "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+const (
+	testUUID        = "7a1707f0-166f-4c4b-bc9d-1d54c74e0137"
+	testObjectId    = "5f2d6d8c3e4b2a0001a1b2c3"
+	testProfileName = "test-profile"
+	testServiceName = "test-service"
+)
+
+func fields(r Report) []string {
+	var result []string
+	for _, f := range r.LossyFields {
+		result = append(result, f.EntityType+" "+f.EntityName+" "+f.Field)
+	}
+	return result
+}
+
+func TestMigrator_Device(t *testing.T) {
+	d := v1.Device{
+		DescribedObject: v1.DescribedObject{Timestamps: v1.Timestamps{Created: 1, Modified: 2, Origin: 3}, Description: "test device"},
+		Id:              testObjectId,
+		Name:            "test-device",
+		AdminState:      v1.Locked,
+		OperatingState:  v1.Enabled,
+		Protocols:       map[string]v1.ProtocolProperties{"modbus-tcp": {"Address": "localhost", "Port": "502"}},
+		Labels:          []string{"label"},
+		Service:         v1.DeviceService{Name: testServiceName},
+		Profile:         v1.DeviceProfile{Name: testProfileName},
+		AutoEvents:      []v1.AutoEvent{{Frequency: "10s", OnChange: true, Resource: "temperature"}},
+	}
+	m := NewMigrator()
+	result, err := m.Device(d)
+	require.NoError(t, err)
+	assert.Equal(t, dtos.Device{
+		DBTimestamp:    dtos.DBTimestamp{Created: 1, Modified: 2},
+		Name:           "test-device",
+		Description:    "test device",
+		AdminState:     models.Locked,
+		OperatingState: models.Up,
+		Labels:         []string{"label"},
+		ServiceName:    testServiceName,
+		ProfileName:    testProfileName,
+		AutoEvents:     []dtos.AutoEvent{{Interval: "10s", OnChange: true, SourceName: "temperature"}},
+		Protocols:      map[string]dtos.ProtocolProperties{"modbus-tcp": {"Address": "localhost", "Port": "502"}},
+	}, result)
+	assert.NoError(t, v2.Validate(result))
+	assert.Equal(t, []string{"Device test-device id"}, fields(m.Report()))
+
+	d.OperatingState = "BROKEN"
+	_, err = m.Device(d)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestMigrator_DeviceService(t *testing.T) {
+	ds := v1.DeviceService{
+		Id:             testUUID,
+		Name:           testServiceName,
+		OperatingState: v1.Disabled,
+		Addressable: v1.Addressable{
+			Protocol: "HTTP", Address: "edgex-device-modbus", Port: 49991, Path: "/api/v1/callback", HTTPMethod: "POST", Password: "secret",
+		},
+	}
+	m := NewMigrator()
+	result, err := m.DeviceService(ds)
+	require.NoError(t, err)
+	assert.Equal(t, testUUID, result.Id)
+	assert.Equal(t, "http://edgex-device-modbus:49991", result.BaseAddress)
+	assert.Equal(t, models.Unlocked, result.AdminState)
+	assert.NoError(t, v2.Validate(result))
+	assert.Equal(t, []string{
+		"DeviceService test-service addressable.path",
+		"DeviceService test-service addressable.method",
+		"DeviceService test-service addressable.password",
+		"DeviceService test-service operatingState",
+	}, fields(m.Report()))
+	assert.Equal(t, "***", m.Report().LossyFields[2].Value, "the password should not be reported")
+
+	ds.Addressable = v1.Addressable{}
+	_, err = m.DeviceService(ds)
+	require.Error(t, err)
+}
+
+func TestMigrator_ProvisionWatcher(t *testing.T) {
+	pw := v1.ProvisionWatcher{
+		Name:                "test-watcher",
+		Identifiers:         map[string]string{"Address": "192.168.0.*"},
+		BlockingIdentifiers: map[string][]string{"Port": {"502"}},
+		Profile:             v1.DeviceProfile{Name: testProfileName},
+		Service:             v1.DeviceService{Name: testServiceName},
+		AdminState:          v1.Unlocked,
+		OperatingState:      v1.Enabled,
+	}
+	m := NewMigrator()
+	result, err := m.ProvisionWatcher(pw)
+	require.NoError(t, err)
+	assert.Equal(t, dtos.ProvisionWatcher{
+		Name:                "test-watcher",
+		Identifiers:         pw.Identifiers,
+		BlockingIdentifiers: pw.BlockingIdentifiers,
+		ProfileName:         testProfileName,
+		ServiceName:         testServiceName,
+		AdminState:          models.Unlocked,
+	}, result)
+	assert.NoError(t, v2.Validate(result))
+	assert.True(t, m.Report().IsLossless())
+
+	pw.OperatingState = v1.Disabled
+	_, err = m.ProvisionWatcher(pw)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"ProvisionWatcher test-watcher operatingState"}, fields(m.Report()))
+}
+
+func TestBaseAddress(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  string
+		port     int
+		expected string
+	}{
+		{"host and port", "edgex-device-modbus", 49991, "http://edgex-device-modbus:49991"},
+		{"host without port", "edgex-device-modbus", 0, "http://edgex-device-modbus"},
+		{"IPv6 and port", "::1", 49991, "http://[::1]:49991"},
+		{"IPv6 without port", "::1", 0, "http://[::1]"},
+		{"bracketed IPv6 and port", "[fe80::1]", 49991, "http://[fe80::1]:49991"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			assert.Equal(t, testCase.expected, baseAddress("HTTP", testCase.address, testCase.port))
+		})
+	}
+}
+
+func TestBaseAddress_Protocol(t *testing.T) {
+	assert.Equal(t, "https://localhost:443", baseAddress("HTTPS", "localhost", 443))
+	assert.Equal(t, "tcp://[::1]:1883", baseAddress("TCP", "::1", 1883))
+}
+
+const testV1ProfileYAML = `
+name: "test-profile"
+manufacturer: "IOTech"
+model: "test"
+description: "v1 profile"
+labels: ["test"]
+deviceResources:
+  -
+    name: "temperature"
+    description: "the temperature"
+    attributes: { register: "1" }
+    properties:
+      value: { type: "float32", readWrite: "r", floatEncoding: "Base64", size: "4" }
+      units: { type: "String", readWrite: "R", defaultValue: "degrees" }
+  -
+    name: "humidity"
+    properties:
+      value: { type: "Int16", readWrite: "RW", defaultValue: "0" }
+      units: { defaultValue: "%" }
+  -
+    name: "switch"
+    properties:
+      value: { type: "Bool" }
+deviceCommands:
+  -
+    name: "values"
+    get:
+      - { operation: "get", deviceResource: "temperature" }
+      - { operation: "get", object: "humidity", mappings: { "0": "dry" } }
+    set:
+      - { operation: "set", deviceResource: "humidity", parameter: "50" }
+      - { operation: "set", deviceResource: "switch", parameter: "true" }
+  -
+    name: "empty"
+coreCommands:
+  -
+    name: "values"
+    get: { path: "/api/v1/device/{deviceId}/values" }
+  -
+    name: "temperature"
+    get: { path: "/api/v1/device/{deviceId}/temperature" }
+  -
+    name: "control"
+    put: { path: "/api/v1/device/{deviceId}/control", parameterNames: ["switch", "unknown"] }
+  -
+    name: "status"
+    get:
+      path: "/api/v1/device/{deviceId}/status"
+      responses: [{ code: "200", description: "OK" }]
+`
+
+func TestMigrator_DeviceProfileYAML(t *testing.T) {
+	m := NewMigrator()
+	result, err := m.DeviceProfileYAML([]byte(testV1ProfileYAML))
+	require.NoError(t, err)
+	assert.NoError(t, v2.Validate(result))
+
+	assert.Equal(t, "IOTech", result.Manufacturer)
+	assert.Equal(t, "v1 profile", result.Description)
+	require.Len(t, result.DeviceResources, 3)
+	assert.Equal(t, dtos.DeviceResource{
+		Name:        "temperature",
+		Description: "the temperature",
+		Properties:  dtos.ResourceProperties{ValueType: v2.ValueTypeFloat32, ReadWrite: "R", Units: "degrees"},
+		Attributes:  map[string]interface{}{"register": "1"},
+	}, result.DeviceResources[0])
+	assert.Equal(t, "%", result.DeviceResources[1].Properties.Units)
+	assert.Equal(t, "RW", result.DeviceResources[2].Properties.ReadWrite)
+
+	assert.Equal(t, []dtos.DeviceCommand{
+		{
+			Name:      "values",
+			ReadWrite: "RW",
+			ResourceOperations: []dtos.ResourceOperation{
+				{DeviceResource: "temperature"},
+				{DeviceResource: "humidity", DefaultValue: "50", Mappings: map[string]string{"0": "dry"}},
+				{DeviceResource: "switch", DefaultValue: "true"},
+			},
+		},
+		{Name: "control", ReadWrite: "W", ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "switch"}}},
+	}, result.DeviceCommands)
+
+	assert.Equal(t, []string{
+		"DeviceProfile test-profile deviceResources[temperature].properties.value.size",
+		"DeviceProfile test-profile deviceResources[temperature].properties.value.floatEncoding",
+		"DeviceProfile test-profile deviceResources[temperature].properties.units.type",
+		"DeviceProfile test-profile deviceResources[switch].properties.value.readWrite",
+		"DeviceProfile test-profile deviceCommands[values]",
+		"DeviceProfile test-profile deviceCommands[empty]",
+		"DeviceProfile test-profile coreCommands[status].responses",
+		"DeviceProfile test-profile coreCommands[status]",
+	}, fields(m.Report()))
+}
+
+func TestMigrator_DeviceProfile_InvalidValueType(t *testing.T) {
+	p := v1.DeviceProfile{
+		Name:            testProfileName,
+		DeviceResources: []v1.DeviceResource{{Name: "temperature", Properties: v1.ProfileProperty{Value: v1.PropertyValue{Type: "Decimal"}}}},
+	}
+	_, err := NewMigrator().DeviceProfile(p)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	_, err = NewMigrator().DeviceProfileYAML([]byte("name: ["))
+	require.Error(t, err)
+}
@@ -0,0 +1,112 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package migration converts the v1 models into the v2 DTOs, so that the v1 databases and device profile files can
+// be migrated to the v2 services. The v1 fields which have no v2 counterpart are dropped and recorded in the Report.
+package migration
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+// Constants for the entity types of the LossyField
+const (
+	EntityDevice           = "Device"
+	EntityDeviceProfile    = "DeviceProfile"
+	EntityDeviceService    = "DeviceService"
+	EntityProvisionWatcher = "ProvisionWatcher"
+	EntityInterval         = "Interval"
+	EntityIntervalAction   = "IntervalAction"
+	EntitySubscription     = "Subscription"
+	EntityNotification     = "Notification"
+)
+
+// LossyField is a v1 field whose value is dropped or only approximated by the migration
+type LossyField struct {
+	EntityType string
+	EntityName string
+	// Field is the path of the v1 field, e.g. deviceResources[temperature].properties.value.size
+	Field  string
+	Value  string
+	Reason string
+}
+
+// String returns the readable description of the LossyField
+func (f LossyField) String() string {
+	return fmt.Sprintf("%s %s: %s=%s %s", f.EntityType, f.EntityName, f.Field, f.Value, f.Reason)
+}
+
+// Report lists the lossy fields of all the entities migrated by a Migrator
+type Report struct {
+	LossyFields []LossyField
+}
+
+// IsLossless returns true if no field is dropped or approximated
+func (r Report) IsLossless() bool {
+	return len(r.LossyFields) == 0
+}
+
+// String returns the readable description of the Report, one lossy field per line
+func (r Report) String() string {
+	lines := make([]string, len(r.LossyFields))
+	for i, f := range r.LossyFields {
+		lines[i] = f.String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// Migrator converts the v1 models into the v2 DTOs and records the lossy fields in its Report. A Migrator is not
+// safe for concurrent use.
+type Migrator struct {
+	report Report
+}
+
+// NewMigrator creates a Migrator with an empty Report
+func NewMigrator() *Migrator {
+	return &Migrator{}
+}
+
+// Report returns the lossy fields recorded so far
+func (m *Migrator) Report() Report {
+	return m.report
+}
+
+func (m *Migrator) lossy(entityType, entityName, field string, value interface{}, reason string) {
+	m.report.LossyFields = append(m.report.LossyFields, LossyField{
+		EntityType: entityType,
+		EntityName: entityName,
+		Field:      field,
+		Value:      fmt.Sprintf("%v", value),
+		Reason:     reason,
+	})
+}
+
+// lossyIfSet records the field only if it has a value
+func (m *Migrator) lossyIfSet(entityType, entityName, field string, value string, reason string) {
+	if value != "" {
+		m.lossy(entityType, entityName, field, value, reason)
+	}
+}
+
+// id keeps the v1 id only if it's a UUID, e.g. the ids of the v1 MongoDB records are not
+func (m *Migrator) id(entityType, entityName, id string) string {
+	if id == "" {
+		return ""
+	}
+	if _, err := uuid.Parse(id); err != nil {
+		m.lossy(entityType, entityName, "id", id, "is not a UUID, a new id will be generated")
+		return ""
+	}
+	return id
+}
+
+func dbTimestamp(created, modified int64) dtos.DBTimestamp {
+	return dtos.DBTimestamp{Created: created, Modified: modified}
+}
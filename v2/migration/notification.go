@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	v1 "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+// Subscription converts the v1 Subscription, whose slug becomes the v2 name. The v1 REST channels become the v2
+// REST addresses, or the webhook addresses if their URLs are https, as parsed by models.ParseAddress.
+func (m *Migrator) Subscription(s v1.Subscription) (dtos.Subscription, errors.EdgeX) {
+	channels := make([]dtos.Address, len(s.Channels))
+	for i, c := range s.Channels {
+		address, err := m.channel(c)
+		if err != nil {
+			return dtos.Subscription{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the subscription %s", s.Slug), err)
+		}
+		channels[i] = address
+	}
+	var categories []string
+	for _, c := range s.SubscribedCategories {
+		categories = append(categories, string(c))
+	}
+	return dtos.Subscription{
+		DBTimestamp: dbTimestamp(s.Created, s.Modified),
+		Id:          m.id(EntitySubscription, s.Slug, s.ID),
+		Name:        s.Slug,
+		Channels:    channels,
+		Receiver:    s.Receiver,
+		Categories:  categories,
+		Labels:      s.SubscribedLabels,
+		Description: s.Description,
+		AdminState:  models.Unlocked,
+	}, nil
+}
+
+// channel converts the v1 Channel, whose REST url is parsed by models.ParseAddress into a REST or webhook address
+func (m *Migrator) channel(c v1.Channel) (dtos.Address, errors.EdgeX) {
+	switch c.Type {
+	case v1.Email:
+		return dtos.NewEmailAddress(c.MailAddresses), nil
+	case v1.Rest:
+		parsed, err := models.ParseAddress(c.Url)
+		if err != nil {
+			return dtos.Address{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid REST channel url %s", c.Url), err)
+		}
+		address := dtos.FromAddressModelToDTO(parsed)
+		if address.Type != v2.REST && address.Type != v2.WEBHOOK {
+			return dtos.Address{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("REST channel url %s is not an http or https url", c.Url), nil)
+		}
+		address.HTTPMethod = http.MethodPost
+		return address, nil
+	default:
+		return dtos.Address{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported channel type %s", c.Type), nil)
+	}
+}
+
+// Notification converts the v1 Notification. The v1 slug is dropped since the v2 notifications are identified by
+// their ids, and the Severity and Status are parsed case-insensitively into the v2 NotificationSeverity and
+// NotificationStatus.
+func (m *Migrator) Notification(n v1.Notification) (dtos.Notification, errors.EdgeX) {
+	severity, err := models.ParseNotificationSeverity(string(n.Severity))
+	if err != nil {
+		return dtos.Notification{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the notification %s", n.Slug), err)
+	}
+	var status models.NotificationStatus
+	if n.Status != "" {
+		if status, err = models.ParseNotificationStatus(string(n.Status)); err != nil {
+			return dtos.Notification{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the notification %s", n.Slug), err)
+		}
+	}
+	m.lossyIfSet(EntityNotification, n.Slug, "slug", n.Slug, "is not supported by v2")
+	return dtos.Notification{
+		DBTimestamp: dbTimestamp(n.Created, n.Modified),
+		Id:          m.id(EntityNotification, n.Slug, n.ID),
+		Category:    string(n.Category),
+		Labels:      n.Labels,
+		Content:     n.Content,
+		ContentType: n.ContentType,
+		Description: n.Description,
+		Sender:      n.Sender,
+		Severity:    severity.String(),
+		Status:      status.String(),
+	}, nil
+}
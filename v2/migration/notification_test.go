@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	v1 "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+func TestMigrator_Subscription(t *testing.T) {
+	s := v1.Subscription{
+		ID:                   testObjectId,
+		Slug:                 "test-subscription",
+		Receiver:             "admin",
+		Description:          "test",
+		SubscribedCategories: []v1.NotificationsCategory{v1.Swhealth},
+		SubscribedLabels:     []string{"label"},
+		Channels: []v1.Channel{
+			{Type: v1.Email, MailAddresses: []string{"admin@example.com"}},
+			{Type: v1.Rest, Url: "http://localhost:7770/api/v1/notification?debug=true"},
+			{Type: v1.Rest, Url: "https://example.com/hook"},
+		},
+	}
+	m := NewMigrator()
+	result, err := m.Subscription(s)
+	require.NoError(t, err)
+
+	restAddress := dtos.NewRESTAddress("localhost", 7770, "POST")
+	// The query is kept in the path as models.ParseAddress does
+	restAddress.Path = "/api/v1/notification?debug=true"
+	assert.Equal(t, dtos.Subscription{
+		Name:        "test-subscription",
+		Receiver:    "admin",
+		Description: "test",
+		Categories:  []string{v1.Swhealth},
+		Labels:      []string{"label"},
+		Channels: []dtos.Address{
+			dtos.NewEmailAddress([]string{"admin@example.com"}),
+			restAddress,
+			dtos.NewWebhookAddress("https", "example.com", 443, "/hook", "POST"),
+		},
+		AdminState: models.Unlocked,
+	}, result)
+	assert.NoError(t, v2.Validate(result))
+	assert.Equal(t, []string{"Subscription test-subscription id"}, fields(m.Report()))
+
+	s.Channels = []v1.Channel{{Type: v1.Rest, Url: "http://[::1]/api"}}
+	result, err = m.Subscription(s)
+	require.NoError(t, err)
+	ipv6Address := dtos.NewRESTAddress("::1", 80, "POST")
+	ipv6Address.Path = "/api"
+	assert.Equal(t, []dtos.Address{ipv6Address}, result.Channels)
+
+	for _, c := range []v1.Channel{{Type: "SMS"}, {Type: v1.Rest, Url: "tcp://localhost:1883"}, {Type: v1.Rest, Url: "localhost/api"}} {
+		s.Channels = []v1.Channel{c}
+		_, err = m.Subscription(s)
+		require.Error(t, err)
+	}
+}
+
+func TestMigrator_Notification(t *testing.T) {
+	n := v1.Notification{
+		Timestamps:  v1.Timestamps{Created: 1, Modified: 2},
+		ID:          testUUID,
+		Slug:        "test-notification",
+		Sender:      "core-metadata",
+		Category:    v1.Swhealth,
+		Severity:    v1.Critical,
+		Content:     "device is down",
+		Status:      v1.New,
+		Labels:      []string{"label"},
+		ContentType: "text/plain",
+	}
+	m := NewMigrator()
+	result, err := m.Notification(n)
+	require.NoError(t, err)
+	assert.Equal(t, dtos.Notification{
+		DBTimestamp: dtos.DBTimestamp{Created: 1, Modified: 2},
+		Id:          testUUID,
+		Category:    v1.Swhealth,
+		Labels:      []string{"label"},
+		Content:     "device is down",
+		ContentType: "text/plain",
+		Sender:      "core-metadata",
+		Severity:    models.Critical,
+		Status:      models.New,
+	}, result)
+	assert.NoError(t, v2.Validate(result))
+	assert.Equal(t, []string{"Notification test-notification slug"}, fields(m.Report()))
+
+	n.Severity, n.Status = "normal", ""
+	result, err = m.Notification(n)
+	require.NoError(t, err)
+	assert.Equal(t, models.Normal, result.Severity)
+	assert.Empty(t, result.Status)
+
+	n.Severity = "MAJOR"
+	_, err = m.Notification(n)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	n.Severity, n.Status = v1.Critical, "DONE"
+	_, err = m.Notification(n)
+	require.Error(t, err)
+}
@@ -0,0 +1,117 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"fmt"
+	"net/http"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	v1 "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+// iso8601DurationRegex matches the legacy v1 frequency format such as P1DT12H
+var iso8601DurationRegex = regexp.MustCompile(`^P(?:(\d+)Y)?(?:(\d+)M)?(?:(\d+)D)?(?:T(?:(\d+)H)?(?:(\d+)M)?(?:(\d+)S)?)?$`)
+
+// Interval converts the v1 Interval, whose frequency becomes the v2 interval duration. The cron expressions are not
+// supported by v2, so an Interval with only a cron expression can't be migrated.
+func (m *Migrator) Interval(i v1.Interval) (dtos.Interval, errors.EdgeX) {
+	if i.Frequency == "" {
+		return dtos.Interval{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("failed to migrate the interval %s, which has no frequency", i.Name), nil)
+	}
+	m.lossyIfSet(EntityInterval, i.Name, "cron", i.Cron, "is not supported by v2, the frequency is used instead")
+	interval, err := m.duration(i.Name, i.Frequency)
+	if err != nil {
+		return dtos.Interval{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to migrate the interval %s", i.Name), err)
+	}
+	return dtos.Interval{
+		DBTimestamp: dbTimestamp(i.Timestamps.Created, i.Timestamps.Modified),
+		Id:          m.id(EntityInterval, i.Name, i.ID),
+		Name:        i.Name,
+		Start:       i.Start,
+		End:         i.End,
+		Interval:    interval,
+		RunOnce:     i.RunOnce,
+	}, nil
+}
+
+// duration converts the v1 frequency, which is either a Go duration or an ISO 8601 duration, into a Go duration.
+// The years and months of an ISO 8601 duration are approximated as 365 and 30 days.
+func (m *Migrator) duration(intervalName string, frequency string) (string, errors.EdgeX) {
+	if _, err := time.ParseDuration(frequency); err == nil {
+		return frequency, nil
+	}
+	matches := iso8601DurationRegex.FindStringSubmatch(frequency)
+	if matches == nil || frequency == "P" || strings.HasSuffix(frequency, "T") {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid frequency %s", frequency), nil)
+	}
+	units := []time.Duration{365 * 24 * time.Hour, 30 * 24 * time.Hour, 24 * time.Hour, time.Hour, time.Minute, time.Second}
+	var d time.Duration
+	for i, unit := range units {
+		if matches[i+1] == "" {
+			continue
+		}
+		n, err := strconv.Atoi(matches[i+1])
+		if err != nil {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid frequency %s", frequency), err)
+		}
+		d += time.Duration(n) * unit
+	}
+	if matches[1] != "" || matches[2] != "" {
+		m.lossy(EntityInterval, intervalName, "frequency", frequency, fmt.Sprintf("is approximated as %s, assuming 365-day years and 30-day months", d))
+	}
+	return d.String(), nil
+}
+
+// IntervalAction converts the v1 IntervalAction, whose protocol, address and port become the v2 Address. The v1
+// parameters become the content of the v2 IntervalAction.
+func (m *Migrator) IntervalAction(ia v1.IntervalAction) (dtos.IntervalAction, errors.EdgeX) {
+	var address dtos.Address
+	protocol := strings.ToUpper(ia.Protocol)
+	switch {
+	case protocol == "HTTP" || protocol == "HTTPS":
+		method := strings.ToUpper(ia.HTTPMethod)
+		if method == "" {
+			method = http.MethodPost
+		}
+		if protocol == "HTTPS" {
+			address = dtos.NewWebhookAddress("https", ia.Address, ia.Port, ia.Path, method)
+		} else {
+			address = dtos.NewRESTAddress(ia.Address, ia.Port, method)
+			address.Path = ia.Path
+		}
+		m.lossyIfSet(EntityIntervalAction, ia.Name, "publisher", ia.Publisher, "is not used by the v2 REST address")
+		m.lossyIfSet(EntityIntervalAction, ia.Name, "topic", ia.Topic, "is not used by the v2 REST address")
+	case ia.Publisher != "" || ia.Topic != "":
+		address = dtos.NewMQTTAddress(ia.Address, ia.Port, ia.Publisher, ia.Topic)
+		m.lossyIfSet(EntityIntervalAction, ia.Name, "path", ia.Path, "is not used by the v2 MQTT address")
+	default:
+		return dtos.IntervalAction{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+			fmt.Sprintf("failed to migrate the interval action %s, unsupported protocol %s", ia.Name, ia.Protocol), nil)
+	}
+	m.lossyIfSet(EntityIntervalAction, ia.Name, "target", ia.Target, "is not supported by v2")
+	const credentialReason = "is not supported by v2, the credentials should be put into the secret store"
+	m.lossyIfSet(EntityIntervalAction, ia.Name, "user", ia.User, credentialReason)
+	if ia.Password != "" {
+		m.lossy(EntityIntervalAction, ia.Name, "password", "***", credentialReason)
+	}
+	return dtos.IntervalAction{
+		DBTimestamp:  dbTimestamp(ia.Created, ia.Modified),
+		Id:           m.id(EntityIntervalAction, ia.Name, ia.ID),
+		Name:         ia.Name,
+		IntervalName: ia.Interval,
+		Address:      address,
+		Content:      ia.Parameters,
+		AdminState:   models.Unlocked,
+	}, nil
+}
@@ -0,0 +1,92 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package migration
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/edgexfoundry/go-mod-core-contracts/v2/models"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+func TestMigrator_Interval(t *testing.T) {
+	tests := []struct {
+		name             string
+		frequency        string
+		cron             string
+		expectedInterval string
+		expectedLossy    []string
+		expectedErr      bool
+	}{
+		{"go duration", "1h30m", "", "1h30m", nil, false},
+		{"iso 8601 duration", "PT1H30M", "", "1h30m0s", nil, false},
+		{"iso 8601 days", "P1DT12H", "", "36h0m0s", nil, false},
+		{"iso 8601 months are approximated", "P1M", "", "720h0m0s", []string{"Interval test-interval frequency"}, false},
+		{"cron is dropped", "10s", "0 * * * *", "10s", []string{"Interval test-interval cron"}, false},
+		{"cron only", "", "0 * * * *", "", nil, true},
+		{"invalid frequency", "PT", "", "", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMigrator()
+			result, err := m.Interval(v1.Interval{Name: "test-interval", Start: "20210101T000000", Frequency: tt.frequency, Cron: tt.cron})
+			if tt.expectedErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedInterval, result.Interval)
+			assert.Equal(t, "20210101T000000", result.Start)
+			assert.NoError(t, v2.Validate(result))
+			assert.Equal(t, tt.expectedLossy, fields(m.Report()))
+		})
+	}
+}
+
+func TestMigrator_IntervalAction(t *testing.T) {
+	rest := v1.IntervalAction{
+		ID: testUUID, Name: "scrub", Interval: "hourly", Target: "core-data", Parameters: `{"age": 3600}`,
+		Protocol: "http", HTTPMethod: "delete", Address: "localhost", Port: 48080, Path: "/api/v1/event/removeold/age/3600",
+	}
+	https := rest
+	https.Protocol = "HTTPS"
+	mqtt := v1.IntervalAction{Name: "publish", Interval: "hourly", Protocol: "TCP", Address: "broker", Port: 1883,
+		Publisher: "edgex", Topic: "events", User: "admin", Password: "secret"}
+
+	restAddress := dtos.NewRESTAddress("localhost", 48080, "DELETE")
+	restAddress.Path = rest.Path
+	tests := []struct {
+		name            string
+		action          v1.IntervalAction
+		expectedAddress dtos.Address
+		expectedLossy   []string
+	}{
+		{"rest", rest, restAddress, []string{"IntervalAction scrub target"}},
+		{"https", https, dtos.NewWebhookAddress("https", "localhost", 48080, rest.Path, "DELETE"), []string{"IntervalAction scrub target"}},
+		{"mqtt", mqtt, dtos.NewMQTTAddress("broker", 1883, "edgex", "events"), []string{"IntervalAction publish user", "IntervalAction publish password"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := NewMigrator()
+			result, err := m.IntervalAction(tt.action)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expectedAddress, result.Address)
+			assert.Equal(t, tt.action.Interval, result.IntervalName)
+			assert.Equal(t, tt.action.Parameters, result.Content)
+			assert.Equal(t, models.Unlocked, result.AdminState)
+			assert.NoError(t, v2.Validate(result))
+			assert.Equal(t, tt.expectedLossy, fields(m.Report()))
+		})
+	}
+
+	_, err := NewMigrator().IntervalAction(v1.IntervalAction{Name: "unknown", Protocol: "UDP"})
+	require.Error(t, err)
+}
@@ -7,6 +7,11 @@ package models
 
 import (
 	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
 
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
 	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
@@ -14,8 +19,23 @@ import (
 
 type Address interface {
 	GetBaseAddress() BaseAddress
+	// URL renders the address as an URL, e.g. http://host:port/path, tcp://host:port or mailto:recipient
+	URL() string
 }
 
+// Constants for the URL schemes and their default ports used to render and parse the Address
+const (
+	schemeHTTP   = "http"
+	schemeHTTPS  = "https"
+	schemeTCP    = "tcp"
+	schemeMQTT   = "mqtt"
+	schemeMailto = "mailto"
+
+	defaultHTTPPort  = 80
+	defaultHTTPSPort = 443
+	defaultMQTTPort  = 1883
+)
+
 // instantiateAddress instantiate the interface to the corresponding address type
 func instantiateAddress(i interface{}) (address Address, err error) {
 	a, err := json.Marshal(i)
@@ -45,6 +65,12 @@ func unmarshalAddress(b []byte) (address Address, err error) {
 			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal MQTT address.", err)
 		}
 		address = mqtt
+	case v2.WEBHOOK:
+		var webhook WebhookAddress
+		if err = json.Unmarshal(b, &webhook); err != nil {
+			return address, errors.NewCommonEdgeX(errors.KindContractInvalid, "Failed to unmarshal Webhook address.", err)
+		}
+		address = webhook
 	case v2.EMAIL:
 		var mail EmailAddress
 		if err = json.Unmarshal(b, &mail); err != nil {
@@ -59,7 +85,7 @@ func unmarshalAddress(b []byte) (address Address, err error) {
 
 // BaseAddress is a base struct contains the common fields, such as type, host, port, and so on.
 type BaseAddress struct {
-	// Type is used to identify the Address type, i.e., REST or MQTT
+	// Type is used to identify the Address type, i.e., REST, MQTT, EMAIL or WEBHOOK
 	Type string
 
 	// Common properties
@@ -76,6 +102,8 @@ type RESTAddress struct {
 
 func (a RESTAddress) GetBaseAddress() BaseAddress { return a.BaseAddress }
 
+func (a RESTAddress) URL() string { return buildURL(schemeHTTP, a.BaseAddress, a.Path) }
+
 // MQTTPubAddress is a MQTT specific struct
 type MQTTPubAddress struct {
 	BaseAddress
@@ -86,10 +114,37 @@ type MQTTPubAddress struct {
 	Retained       bool
 	AutoReconnect  bool
 	ConnectTimeout int
+	AuthMode       string
+	SecretPath     string
+	SkipCertVerify bool
 }
 
 func (a MQTTPubAddress) GetBaseAddress() BaseAddress { return a.BaseAddress }
 
+func (a MQTTPubAddress) URL() string { return buildURL(schemeTCP, a.BaseAddress, "") }
+
+// WebhookAddress is a REST specific struct with the extra properties required by third-party webhooks
+type WebhookAddress struct {
+	BaseAddress
+	Path                string
+	HTTPMethod          string
+	Scheme              string
+	Headers             map[string]string
+	SecretName          string
+	BodyTemplate        string
+	ExpectedStatusCodes []int
+}
+
+func (a WebhookAddress) GetBaseAddress() BaseAddress { return a.BaseAddress }
+
+func (a WebhookAddress) URL() string {
+	scheme := a.Scheme
+	if scheme == "" {
+		scheme = schemeHTTP
+	}
+	return buildURL(scheme, a.BaseAddress, a.Path)
+}
+
 // EmailAddress is an Email specific struct
 type EmailAddress struct {
 	BaseAddress
@@ -97,3 +152,111 @@ type EmailAddress struct {
 }
 
 func (a EmailAddress) GetBaseAddress() BaseAddress { return a.BaseAddress }
+
+func (a EmailAddress) URL() string {
+	recipients := make([]string, len(a.Recipients))
+	for i, r := range a.Recipients {
+		recipients[i] = url.PathEscape(r)
+	}
+	return schemeMailto + ":" + strings.Join(recipients, ",")
+}
+
+// buildURL renders the scheme, host, port and path as an URL. The IPv6 host is enclosed in square brackets unless it
+// already is, the port is omitted if not specified, and the leading slash of the path is added if missing.
+func buildURL(scheme string, base BaseAddress, path string) string {
+	host := base.Host
+	if strings.HasPrefix(host, "[") && strings.HasSuffix(host, "]") {
+		host = host[1 : len(host)-1]
+	}
+	if base.Port != 0 {
+		host = net.JoinHostPort(host, strconv.Itoa(base.Port))
+	} else if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	u := url.URL{Scheme: scheme, Host: host}
+	if path != "" {
+		if i := strings.Index(path, "?"); i >= 0 {
+			path, u.RawQuery = path[:i], path[i+1:]
+		}
+		if !strings.HasPrefix(path, "/") {
+			path = "/" + path
+		}
+		u.Path = path
+	}
+	return u.String()
+}
+
+// ParseAddress parses the URL rendered by Address.URL back to the Address. The http scheme results in a RESTAddress,
+// https in a WebhookAddress, tcp and mqtt in a MQTTPubAddress, and mailto in an EmailAddress. The default port of the
+// scheme is used if the URL does not specify one.
+// A WebhookAddress with the http scheme renders the same URL as a RESTAddress, so it is parsed back as a RESTAddress.
+// Properties which are not part of the URL, such as the HTTPMethod or the MQTT Topic, are left empty and should be
+// filled in by the caller.
+func ParseAddress(rawURL string) (Address, errors.EdgeX) {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to parse the address %s", rawURL), err)
+	}
+
+	scheme := strings.ToLower(u.Scheme)
+	if scheme == schemeMailto {
+		return parseEmailAddress(u)
+	}
+
+	var defaultPort int
+	switch scheme {
+	case schemeHTTP:
+		defaultPort = defaultHTTPPort
+	case schemeHTTPS:
+		defaultPort = defaultHTTPSPort
+	case schemeTCP, schemeMQTT:
+		defaultPort = defaultMQTTPort
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported scheme %s of the address %s", u.Scheme, rawURL), nil)
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("host is missing in the address %s", rawURL), nil)
+	}
+	port := defaultPort
+	if p := u.Port(); p != "" {
+		port, err = strconv.Atoi(p)
+		if err != nil || port <= 0 || port > 65535 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid port %s of the address %s", p, rawURL), err)
+		}
+	}
+
+	path := u.Path
+	if u.RawQuery != "" {
+		path = path + "?" + u.RawQuery
+	}
+
+	switch scheme {
+	case schemeHTTP:
+		return RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: host, Port: port}, Path: path}, nil
+	case schemeHTTPS:
+		return WebhookAddress{BaseAddress: BaseAddress{Type: v2.WEBHOOK, Host: host, Port: port}, Path: path, Scheme: schemeHTTPS}, nil
+	default:
+		if path != "" && path != "/" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("path is not allowed in the MQTT address %s", rawURL), nil)
+		}
+		return MQTTPubAddress{BaseAddress: BaseAddress{Type: v2.MQTT, Host: host, Port: port}}, nil
+	}
+}
+
+func parseEmailAddress(u *url.URL) (Address, errors.EdgeX) {
+	if u.Opaque == "" {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("recipient is missing in the address %s", u.String()), nil)
+	}
+	parts := strings.Split(u.Opaque, ",")
+	recipients := make([]string, len(parts))
+	for i, p := range parts {
+		r, err := url.PathUnescape(p)
+		if err != nil || r == "" {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid recipient %s in the address %s", p, u.String()), err)
+		}
+		recipients[i] = r
+	}
+	return EmailAddress{BaseAddress: BaseAddress{Type: v2.EMAIL}, Recipients: recipients}, nil
+}
@@ -0,0 +1,138 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAddress_URL(t *testing.T) {
+	tests := []struct {
+		name     string
+		address  Address
+		expected string
+	}{
+		{"REST address",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "localhost", Port: 48080}, Path: "/api/v2/ping"},
+			"http://localhost:48080/api/v2/ping"},
+		{"REST address without leading slash",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "localhost", Port: 48080}, Path: "api/v2/ping"},
+			"http://localhost:48080/api/v2/ping"},
+		{"REST address with query",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "localhost", Port: 48080}, Path: "/api/v2/event/all?offset=0&limit=5"},
+			"http://localhost:48080/api/v2/event/all?offset=0&limit=5"},
+		{"REST address without port",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "localhost"}, Path: "/api"},
+			"http://localhost/api"},
+		{"REST address with IPv6 host",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "::1", Port: 48080}, Path: "/api"},
+			"http://[::1]:48080/api"},
+		{"REST address with IPv6 host without port",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "fe80::1"}},
+			"http://[fe80::1]"},
+		{"REST address with bracketed IPv6 host",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "[::1]", Port: 48080}, Path: "/api"},
+			"http://[::1]:48080/api"},
+		{"REST address with bracketed IPv6 host without port",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "[fe80::1]"}},
+			"http://[fe80::1]"},
+		{"Webhook address",
+			WebhookAddress{BaseAddress: BaseAddress{Type: v2.WEBHOOK, Host: "hooks.example.com", Port: 443}, Path: "/services/T000", Scheme: v2.SchemeHTTPS},
+			"https://hooks.example.com:443/services/T000"},
+		{"Webhook address without scheme",
+			WebhookAddress{BaseAddress: BaseAddress{Type: v2.WEBHOOK, Host: "localhost", Port: 8080}, Path: "/hook"},
+			"http://localhost:8080/hook"},
+		{"MQTT address",
+			MQTTPubAddress{BaseAddress: BaseAddress{Type: v2.MQTT, Host: "localhost", Port: 1883}, Topic: "alarms"},
+			"tcp://localhost:1883"},
+		{"MQTT address with IPv6 host",
+			MQTTPubAddress{BaseAddress: BaseAddress{Type: v2.MQTT, Host: "2001:db8::1", Port: 1883}},
+			"tcp://[2001:db8::1]:1883"},
+		{"Email address",
+			EmailAddress{BaseAddress: BaseAddress{Type: v2.EMAIL}, Recipients: []string{"a@example.com", "b@example.com"}},
+			"mailto:a@example.com,b@example.com"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tt.address.URL())
+		})
+	}
+}
+
+func TestParseAddress(t *testing.T) {
+	tests := []struct {
+		name        string
+		url         string
+		expected    Address
+		expectError bool
+	}{
+		{"valid, REST address", "http://localhost:48080/api/v2/ping",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "localhost", Port: 48080}, Path: "/api/v2/ping"}, false},
+		{"valid, REST address with default port", "http://localhost/api",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "localhost", Port: 80}, Path: "/api"}, false},
+		{"valid, REST address with IPv6 host", "http://[::1]:48080/api?limit=5",
+			RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "::1", Port: 48080}, Path: "/api?limit=5"}, false},
+		{"valid, Webhook address with default port", "https://hooks.example.com/services/T000",
+			WebhookAddress{BaseAddress: BaseAddress{Type: v2.WEBHOOK, Host: "hooks.example.com", Port: 443}, Path: "/services/T000", Scheme: v2.SchemeHTTPS}, false},
+		{"valid, MQTT address", "tcp://localhost:1884",
+			MQTTPubAddress{BaseAddress: BaseAddress{Type: v2.MQTT, Host: "localhost", Port: 1884}}, false},
+		{"valid, MQTT address with mqtt scheme and default port", "mqtt://[fe80::1]",
+			MQTTPubAddress{BaseAddress: BaseAddress{Type: v2.MQTT, Host: "fe80::1", Port: 1883}}, false},
+		{"valid, Email address", "mailto:a@example.com,b@example.com",
+			EmailAddress{BaseAddress: BaseAddress{Type: v2.EMAIL}, Recipients: []string{"a@example.com", "b@example.com"}}, false},
+		{"invalid, unsupported scheme", "ftp://localhost:21", nil, true},
+		{"invalid, no scheme", "localhost:48080", nil, true},
+		{"invalid, no host", "http:///api", nil, true},
+		{"invalid, port out of range", "http://localhost:65536", nil, true},
+		{"invalid, MQTT address with path", "tcp://localhost:1883/alarms", nil, true},
+		{"invalid, Email address without recipient", "mailto:", nil, true},
+		{"invalid, malformed URL", "http://[::1", nil, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseAddress(tt.url)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestAddress_URLRoundTrip(t *testing.T) {
+	addresses := []Address{
+		RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "::1", Port: 48080}, Path: "/api/v2/device/name/a%20b"},
+		WebhookAddress{BaseAddress: BaseAddress{Type: v2.WEBHOOK, Host: "hooks.example.com", Port: 8443}, Path: "/hook", Scheme: v2.SchemeHTTPS},
+		MQTTPubAddress{BaseAddress: BaseAddress{Type: v2.MQTT, Host: "broker", Port: 1883}},
+		EmailAddress{BaseAddress: BaseAddress{Type: v2.EMAIL}, Recipients: []string{"a+alerts@example.com", "b@example.com"}},
+	}
+	for _, a := range addresses {
+		t.Run(a.URL(), func(t *testing.T) {
+			result, err := ParseAddress(a.URL())
+			require.NoError(t, err)
+			assert.Equal(t, a, result)
+			assert.Equal(t, a.URL(), result.URL())
+		})
+	}
+}
+
+func TestParseAddress_HTTPWebhook(t *testing.T) {
+	webhook := WebhookAddress{BaseAddress: BaseAddress{Type: v2.WEBHOOK, Host: "localhost", Port: 8080}, Path: "/hook", Scheme: v2.SchemeHTTP}
+
+	result, err := ParseAddress(webhook.URL())
+
+	// The http Webhook address renders the same URL as the REST address
+	require.NoError(t, err)
+	assert.Equal(t, RESTAddress{BaseAddress: BaseAddress{Type: v2.REST, Host: "localhost", Port: 8080}, Path: "/hook"}, result)
+	assert.Equal(t, webhook.URL(), result.URL())
+}
@@ -10,8 +10,10 @@ const (
 
 // Constants for ChannelType
 const (
-	Rest  = "REST"
-	Email = "EMAIL"
+	Rest    = "REST"
+	Email   = "EMAIL"
+	Mqtt    = "MQTT"
+	Webhook = "WEBHOOK"
 )
 
 // Constants for NotificationSeverity
@@ -21,6 +23,13 @@ const (
 	Normal   = "NORMAL"
 )
 
+// Typed constants for NotificationSeverity
+const (
+	SeverityMinor    NotificationSeverity = Minor
+	SeverityCritical NotificationSeverity = Critical
+	SeverityNormal   NotificationSeverity = Normal
+)
+
 // Constants for NotificationStatus
 const (
 	New       = "NEW"
@@ -31,6 +40,13 @@ const (
 	EscalatedContentNotice     = "This notification is escalated by the transmission"
 )
 
+// Typed constants for NotificationStatus
+const (
+	StatusNew       NotificationStatus = New
+	StatusProcessed NotificationStatus = Processed
+	StatusEscalated NotificationStatus = Escalated
+)
+
 // Constants for TransmissionStatus
 const (
 	Failed       = "FAILED"
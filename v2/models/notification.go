@@ -5,6 +5,13 @@
 
 package models
 
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
 // Notification and its properties are defined in the APIv2 specification:
 // https://app.swaggerhub.com/apis-docs/EdgeXFoundry1/support-notifications/2.x#/Notification
 // Model fields are same as the DTOs documented by this swagger. Exceptions, if any, are noted below.
@@ -26,3 +33,124 @@ type NotificationSeverity string
 
 // NotificationStatus indicates the current processing status of the notification.
 type NotificationStatus string
+
+// notificationSeverities lists the valid NotificationSeverity from the lowest to the highest level
+var notificationSeverities = []NotificationSeverity{Minor, Normal, Critical}
+
+// notificationStatusTransitions lists the NotificationStatus which each NotificationStatus can transition to
+var notificationStatusTransitions = map[NotificationStatus][]NotificationStatus{
+	New:       {Processed, Escalated},
+	Processed: {},
+	Escalated: {},
+}
+
+// ParseNotificationSeverity parses the case-insensitive string to the NotificationSeverity
+func ParseNotificationSeverity(s string) (NotificationSeverity, errors.EdgeX) {
+	for _, severity := range notificationSeverities {
+		if strings.EqualFold(s, string(severity)) {
+			return severity, nil
+		}
+	}
+	return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown notification severity %s", s), nil)
+}
+
+func (s NotificationSeverity) String() string { return string(s) }
+
+// Validate checks whether the NotificationSeverity is one of MINOR, NORMAL and CRITICAL
+func (s NotificationSeverity) Validate() errors.EdgeX {
+	if s.level() < 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown notification severity %s", s), nil)
+	}
+	return nil
+}
+
+// level returns the position of the NotificationSeverity in the ascending order MINOR < NORMAL < CRITICAL, or -1 if
+// the NotificationSeverity is unknown
+func (s NotificationSeverity) level() int {
+	for i, severity := range notificationSeverities {
+		if s == severity {
+			return i
+		}
+	}
+	return -1
+}
+
+// Compare returns -1, 0 or 1 if the NotificationSeverity is lower than, equal to or higher than the other one, per the
+// ascending order MINOR < NORMAL < CRITICAL. An unknown NotificationSeverity is lower than any known one.
+func (s NotificationSeverity) Compare(other NotificationSeverity) int {
+	l, o := s.level(), other.level()
+	switch {
+	case l < o:
+		return -1
+	case l > o:
+		return 1
+	default:
+		return 0
+	}
+}
+
+// AtLeast checks whether the NotificationSeverity is known and not lower than the min one
+func (s NotificationSeverity) AtLeast(min NotificationSeverity) bool {
+	return s.level() >= 0 && s.Compare(min) >= 0
+}
+
+// NotificationSeveritiesAtLeast returns the NotificationSeverity which are not lower than the min one, e.g. MINOR
+// and above, in ascending order
+func NotificationSeveritiesAtLeast(min NotificationSeverity) []NotificationSeverity {
+	var result []NotificationSeverity
+	for _, severity := range notificationSeverities {
+		if severity.AtLeast(min) {
+			result = append(result, severity)
+		}
+	}
+	return result
+}
+
+// ParseNotificationStatus parses the case-insensitive string to the NotificationStatus
+func ParseNotificationStatus(s string) (NotificationStatus, errors.EdgeX) {
+	for status := range notificationStatusTransitions {
+		if strings.EqualFold(s, string(status)) {
+			return status, nil
+		}
+	}
+	return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown notification status %s", s), nil)
+}
+
+func (s NotificationStatus) String() string { return string(s) }
+
+// Validate checks whether the NotificationStatus is one of NEW, PROCESSED and ESCALATED
+func (s NotificationStatus) Validate() errors.EdgeX {
+	if _, ok := notificationStatusTransitions[s]; !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown notification status %s", s), nil)
+	}
+	return nil
+}
+
+// CanTransitionTo checks whether the NotificationStatus can transition to the next one. A NEW notification can become
+// PROCESSED or ESCALATED, which are both final. Keeping the same status is always allowed.
+func (s NotificationStatus) CanTransitionTo(next NotificationStatus) bool {
+	nexts, ok := notificationStatusTransitions[s]
+	if !ok {
+		return false
+	}
+	if s == next {
+		return true
+	}
+	for _, n := range nexts {
+		if n == next {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns the KindStatusConflict error if the NotificationStatus can not transition to the next one
+func (s NotificationStatus) ValidateTransition(next NotificationStatus) errors.EdgeX {
+	if err := next.Validate(); err != nil {
+		return err
+	}
+	if !s.CanTransitionTo(next) {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("notification status can not transition from %s to %s", s, next), nil)
+	}
+	return nil
+}
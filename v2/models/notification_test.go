@@ -0,0 +1,129 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseNotificationSeverity(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    NotificationSeverity
+		expectError bool
+	}{
+		{"valid, MINOR", "MINOR", Minor, false},
+		{"valid, lower case normal", "normal", Normal, false},
+		{"valid, mixed case critical", "Critical", Critical, false},
+		{"invalid, empty", "", "", true},
+		{"invalid, unknown", "MAJOR", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseNotificationSeverity(tt.value)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, string(tt.expected), result.String())
+			assert.NoError(t, result.Validate())
+		})
+	}
+}
+
+func TestNotificationSeverity_Validate(t *testing.T) {
+	assert.NoError(t, NotificationSeverity(Minor).Validate())
+	assert.Error(t, NotificationSeverity("minor").Validate())
+	assert.Error(t, NotificationSeverity("").Validate())
+}
+
+func TestNotificationSeverity_Ordering(t *testing.T) {
+	minor, normal, critical := NotificationSeverity(Minor), NotificationSeverity(Normal), NotificationSeverity(Critical)
+
+	assert.Equal(t, -1, minor.Compare(normal))
+	assert.Equal(t, -1, normal.Compare(critical))
+	assert.Equal(t, 1, critical.Compare(minor))
+	assert.Equal(t, 0, normal.Compare(normal))
+	assert.Equal(t, -1, NotificationSeverity("unknown").Compare(minor))
+
+	assert.True(t, critical.AtLeast(normal))
+	assert.True(t, normal.AtLeast(normal))
+	assert.False(t, minor.AtLeast(normal))
+	assert.False(t, NotificationSeverity("unknown").AtLeast("unknown"))
+
+	assert.Equal(t, []NotificationSeverity{Minor, Normal, Critical}, NotificationSeveritiesAtLeast(Minor))
+	assert.Equal(t, []NotificationSeverity{Normal, Critical}, NotificationSeveritiesAtLeast(Normal))
+	assert.Equal(t, []NotificationSeverity{Critical}, NotificationSeveritiesAtLeast(Critical))
+}
+
+func TestParseNotificationStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		value       string
+		expected    NotificationStatus
+		expectError bool
+	}{
+		{"valid, NEW", "NEW", New, false},
+		{"valid, lower case processed", "processed", Processed, false},
+		{"valid, ESCALATED", "ESCALATED", Escalated, false},
+		{"invalid, empty", "", "", true},
+		{"invalid, transmission status", "SENT", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ParseNotificationStatus(tt.value)
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			assert.Equal(t, string(tt.expected), result.String())
+			assert.NoError(t, result.Validate())
+		})
+	}
+}
+
+func TestNotificationStatus_ValidateTransition(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        NotificationStatus
+		to          NotificationStatus
+		expectError bool
+	}{
+		{"valid, NEW to PROCESSED", New, Processed, false},
+		{"valid, NEW to ESCALATED", New, Escalated, false},
+		{"valid, NEW to NEW", New, New, false},
+		{"valid, PROCESSED to PROCESSED", Processed, Processed, false},
+		{"invalid, PROCESSED to NEW", Processed, New, true},
+		{"invalid, PROCESSED to ESCALATED", Processed, Escalated, true},
+		{"invalid, ESCALATED to PROCESSED", Escalated, Processed, true},
+		{"invalid, unknown next status", New, "UNKNOWN", true},
+		{"invalid, unknown current status", "UNKNOWN", Processed, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, !tt.expectError, tt.from.CanTransitionTo(tt.to))
+			err := tt.from.ValidateTransition(tt.to)
+			if tt.expectError {
+				require.Error(t, err)
+				if tt.to.Validate() == nil {
+					assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+				}
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,99 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"fmt"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// operatingStateTransitions lists the legal target states of each OperatingState. UNKNOWN is the initial state
+// before the device service gets in touch with the device, so a device can't go back to UNKNOWN once it's UP or DOWN.
+var operatingStateTransitions = map[OperatingState][]OperatingState{
+	Unknown: {Unknown, Up, Down},
+	Up:      {Up, Down},
+	Down:    {Down, Up},
+}
+
+// Validate checks whether the AdminState is LOCKED or UNLOCKED
+func (s AdminState) Validate() errors.EdgeX {
+	if s != Locked && s != Unlocked {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid AdminState %s, should be one of %s, %s", s, Locked, Unlocked), nil)
+	}
+	return nil
+}
+
+// CanTransitionTo checks whether the AdminState can be changed to the target state. Both LOCKED and UNLOCKED can
+// be changed to each other, and setting the same state is a no-op.
+func (s AdminState) CanTransitionTo(target AdminState) bool {
+	return s.Validate() == nil && target.Validate() == nil
+}
+
+// ValidateTransition returns the KindContractInvalid error if either AdminState is invalid
+func (s AdminState) ValidateTransition(target AdminState) errors.EdgeX {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	return target.Validate()
+}
+
+// Validate checks whether the OperatingState is UP, DOWN or UNKNOWN
+func (s OperatingState) Validate() errors.EdgeX {
+	if _, ok := operatingStateTransitions[s]; !ok {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid OperatingState %s, should be one of %s, %s, %s", s, Up, Down, Unknown), nil)
+	}
+	return nil
+}
+
+// CanTransitionTo checks whether the OperatingState can be changed to the target state
+func (s OperatingState) CanTransitionTo(target OperatingState) bool {
+	for _, allowed := range operatingStateTransitions[s] {
+		if target == allowed {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition returns the KindContractInvalid error if either OperatingState is invalid, or the
+// KindStatusConflict error if the OperatingState can't be changed to the target state
+func (s OperatingState) ValidateTransition(target OperatingState) errors.EdgeX {
+	if err := s.Validate(); err != nil {
+		return err
+	}
+	if err := target.Validate(); err != nil {
+		return err
+	}
+	if !s.CanTransitionTo(target) {
+		return errors.NewCommonEdgeX(errors.KindStatusConflict, fmt.Sprintf("OperatingState can't be changed from %s to %s", s, target), nil)
+	}
+	return nil
+}
+
+// CommandAllowed checks whether a command may be issued to the device, which requires both the device and its
+// device service to be UNLOCKED and the device not to be DOWN. A KindServiceLocked error is returned otherwise.
+func CommandAllowed(device Device, serviceAdminState AdminState) errors.EdgeX {
+	if err := serviceAdminState.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device service %s has an invalid state", device.ServiceName), err)
+	}
+	if err := device.AdminState.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device %s has an invalid state", device.Name), err)
+	}
+	if err := device.OperatingState.Validate(); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("device %s has an invalid state", device.Name), err)
+	}
+	if serviceAdminState == Locked {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, fmt.Sprintf("device service %s is locked", device.ServiceName), nil)
+	}
+	if device.AdminState == Locked {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, fmt.Sprintf("device %s is locked", device.Name), nil)
+	}
+	if device.OperatingState == Down {
+		return errors.NewCommonEdgeX(errors.KindServiceLocked, fmt.Sprintf("device %s is down", device.Name), nil)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package models
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+func TestAdminState_ValidateTransition(t *testing.T) {
+	tests := []struct {
+		name        string
+		from        AdminState
+		to          AdminState
+		expectedErr bool
+	}{
+		{"lock", Unlocked, Locked, false},
+		{"unlock", Locked, Unlocked, false},
+		{"unchanged", Locked, Locked, false},
+		{"invalid source", "DISABLED", Locked, true},
+		{"invalid target", Locked, "locked", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, !tt.expectedErr, tt.from.CanTransitionTo(tt.to))
+			err := tt.from.ValidateTransition(tt.to)
+			if tt.expectedErr {
+				require.Error(t, err)
+				assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestOperatingState_ValidateTransition(t *testing.T) {
+	tests := []struct {
+		name         string
+		from         OperatingState
+		to           OperatingState
+		expectedKind errors.ErrKind
+	}{
+		{"unknown to up", Unknown, Up, ""},
+		{"unknown to down", Unknown, Down, ""},
+		{"up to down", Up, Down, ""},
+		{"down to up", Down, Up, ""},
+		{"unchanged", Up, Up, ""},
+		{"up to unknown", Up, Unknown, errors.KindStatusConflict},
+		{"down to unknown", Down, Unknown, errors.KindStatusConflict},
+		{"invalid source", "ENABLED", Up, errors.KindContractInvalid},
+		{"invalid target", Up, "", errors.KindContractInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expectedKind == "", tt.from.CanTransitionTo(tt.to))
+			err := tt.from.ValidateTransition(tt.to)
+			if tt.expectedKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedKind, errors.Kind(err))
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestCommandAllowed(t *testing.T) {
+	device := Device{Name: "device", ServiceName: "service", AdminState: Unlocked, OperatingState: Up}
+	locked := device
+	locked.AdminState = Locked
+	down := device
+	down.OperatingState = Down
+	unknown := device
+	unknown.OperatingState = Unknown
+	invalid := device
+	invalid.OperatingState = "ENABLED"
+
+	tests := []struct {
+		name              string
+		device            Device
+		serviceAdminState AdminState
+		expectedKind      errors.ErrKind
+		expectedMessage   string
+	}{
+		{"allowed", device, Unlocked, "", ""},
+		{"unknown operating state", unknown, Unlocked, "", ""},
+		{"service locked", device, Locked, errors.KindServiceLocked, "device service service is locked"},
+		{"device locked", locked, Unlocked, errors.KindServiceLocked, "device device is locked"},
+		{"device down", down, Unlocked, errors.KindServiceLocked, "device device is down"},
+		{"service locked takes precedence", locked, Locked, errors.KindServiceLocked, "device service service is locked"},
+		{"invalid device state", invalid, Unlocked, errors.KindContractInvalid, ""},
+		{"invalid service state", device, "", errors.KindContractInvalid, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := CommandAllowed(tt.device, tt.serviceAdminState)
+			if tt.expectedKind == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			assert.Equal(t, tt.expectedKind, errors.Kind(err))
+			if tt.expectedMessage != "" {
+				assert.Equal(t, tt.expectedMessage, err.Message())
+			}
+		})
+	}
+}
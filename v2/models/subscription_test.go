@@ -33,6 +33,21 @@ func TestSubscription_UnmarshalJSON(t *testing.T) {
 	valid := subscriptionData()
 	jsonData, err := json.Marshal(valid)
 	require.NoError(t, err)
+	validWebhook := subscriptionData()
+	validWebhook.Channels = []Address{
+		WebhookAddress{
+			BaseAddress:         BaseAddress{Type: v2.WEBHOOK, Host: "localhost", Port: 443},
+			Path:                "/hooks",
+			HTTPMethod:          "POST",
+			Scheme:              v2.SchemeHTTPS,
+			Headers:             map[string]string{"X-Api-Version": "2"},
+			SecretName:          "webhook",
+			BodyTemplate:        `{"text":"{{.Content}}"}`,
+			ExpectedStatusCodes: []int{200},
+		},
+	}
+	webhookJsonData, err := json.Marshal(validWebhook)
+	require.NoError(t, err)
 	tests := []struct {
 		name     string
 		expected Subscription
@@ -40,6 +55,7 @@ func TestSubscription_UnmarshalJSON(t *testing.T) {
 		wantErr  bool
 	}{
 		{"valid, unmarshal Subscription", valid, jsonData, false},
+		{"valid, unmarshal Subscription with Webhook channel", validWebhook, webhookJsonData, false},
 		{"invalid, unmarshal invalid Subscription, empty data", Subscription{}, []byte{}, true},
 		{"invalid, unmarshal invalid Subscription, string data", Subscription{}, []byte("Invalid Subscription"), true},
 	}
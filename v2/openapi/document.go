@@ -0,0 +1,280 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package openapi generates the OpenAPI 3 documents of the v2 services from the route table and the DTOs, so that the
+// API documents stay in sync with the contracts.
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/jsonschema"
+)
+
+// Version is the OpenAPI version of the generated documents, which uses the same JSON Schema dialect as the
+// jsonschema package
+const Version = "3.1.0"
+
+const (
+	componentSchemasRef  = "#/components/schemas/"
+	contentTypeMultipart = "multipart/form-data"
+	uploadFormField      = "file"
+)
+
+// Document is an OpenAPI document
+type Document struct {
+	OpenAPI    string                           `json:"openapi"`
+	Info       Info                             `json:"info"`
+	Servers    []Server                         `json:"servers,omitempty"`
+	Paths      map[string]map[string]*Operation `json:"paths"`
+	Components Components                       `json:"components"`
+}
+
+// Info is the metadata of the API
+type Info struct {
+	Title       string `json:"title"`
+	Version     string `json:"version"`
+	Description string `json:"description,omitempty"`
+}
+
+// Server is the URL of a server serving the API
+type Server struct {
+	URL string `json:"url"`
+}
+
+// Components holds the schemas of the DTOs referred by the operations
+type Components struct {
+	Schemas map[string]*jsonschema.Schema `json:"schemas"`
+}
+
+// Operation is an API operation on a path
+type Operation struct {
+	OperationId string              `json:"operationId"`
+	Summary     string              `json:"summary,omitempty"`
+	Tags        []string            `json:"tags,omitempty"`
+	Parameters  []Parameter         `json:"parameters,omitempty"`
+	RequestBody *RequestBody        `json:"requestBody,omitempty"`
+	Responses   map[string]Response `json:"responses"`
+}
+
+// Parameter is a path or query parameter of an Operation
+type Parameter struct {
+	Name        string  `json:"name"`
+	In          string  `json:"in"`
+	Description string  `json:"description,omitempty"`
+	Required    bool    `json:"required,omitempty"`
+	Schema      *Schema `json:"schema"`
+}
+
+// RequestBody is the request body of an Operation
+type RequestBody struct {
+	Required bool                 `json:"required"`
+	Content  map[string]MediaType `json:"content"`
+}
+
+// Response is a response of an Operation
+type Response struct {
+	Description string               `json:"description"`
+	Content     map[string]MediaType `json:"content,omitempty"`
+}
+
+// MediaType is the schema of a request or response content
+type MediaType struct {
+	Schema *Schema `json:"schema"`
+}
+
+// Schema is the schema of a parameter or a content, which refers to the DTO schemas in the Components
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Default              interface{}        `json:"default,omitempty"`
+	Minimum              *int               `json:"minimum,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	Required             []string           `json:"required,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// NewDocument builds the OpenAPI document of the routes, where the DTOs of the routes become the component schemas.
+// An error is returned if the routes have the same method and path or the same name, or a DTO can't be described.
+func NewDocument(info Info, routes []Route) (*Document, errors.EdgeX) {
+	doc := &Document{
+		OpenAPI:    Version,
+		Info:       info,
+		Servers:    []Server{{URL: v2.ApiBase}},
+		Paths:      make(map[string]map[string]*Operation),
+		Components: Components{Schemas: make(map[string]*jsonschema.Schema)},
+	}
+	names := make(map[string]bool, len(routes))
+	for _, r := range routes {
+		if err := r.validate(); err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		if names[r.Name] {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("duplicate route name %s", r.Name), nil)
+		}
+		names[r.Name] = true
+
+		// The paths are relative to the server URL
+		path := strings.TrimPrefix(r.Path, v2.ApiBase)
+		method := strings.ToLower(r.Method)
+		if _, ok := doc.Paths[path][method]; ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("duplicate route %s %s", r.Method, r.Path), nil)
+		}
+		op, err := doc.operation(r)
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid route %s", r.Name), err)
+		}
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = make(map[string]*Operation)
+		}
+		doc.Paths[path][method] = op
+	}
+	return doc, nil
+}
+
+func (doc *Document) operation(r Route) (*Operation, errors.EdgeX) {
+	op := &Operation{
+		OperationId: r.Name,
+		Summary:     r.Summary,
+		Tags:        []string{tag(r.Path)},
+		Responses:   make(map[string]Response),
+	}
+	names, err := v2.RouteParams(r.Path)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	for _, name := range names {
+		op.Parameters = append(op.Parameters, Parameter{Name: name, In: "path", Required: true, Schema: pathParameterSchema(name)})
+	}
+	for _, name := range r.Query {
+		p, ok := queryParameters[name]
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown query parameter %s", name), nil)
+		}
+		op.Parameters = append(op.Parameters, p)
+	}
+
+	switch {
+	case r.Upload:
+		op.RequestBody = &RequestBody{Required: true, Content: map[string]MediaType{contentTypeMultipart: {Schema: &Schema{
+			Type:       "object",
+			Properties: map[string]*Schema{uploadFormField: {Type: "string", Format: "binary"}},
+			Required:   []string{uploadFormField},
+		}}}}
+	case r.Request != nil:
+		s, err := doc.contentSchema(r.Request, r.RequestArray)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		op.RequestBody = &RequestBody{Required: true, Content: map[string]MediaType{clients.ContentTypeJSON: {Schema: s}}}
+	}
+
+	statusCode := r.StatusCode
+	if statusCode == 0 {
+		statusCode = http.StatusOK
+	}
+	s, err := doc.contentSchema(r.Response, r.ResponseArray)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	op.Responses[strconv.Itoa(statusCode)] = Response{
+		Description: http.StatusText(statusCode),
+		Content:     map[string]MediaType{clients.ContentTypeJSON: {Schema: s}},
+	}
+	// The errors are returned as the BaseResponse with the status code and the message
+	errorSchema, err := doc.contentSchema(common.BaseResponse{}, false)
+	if err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	op.Responses["default"] = Response{
+		Description: "Error",
+		Content:     map[string]MediaType{clients.ContentTypeJSON: {Schema: errorSchema}},
+	}
+	return op, nil
+}
+
+// contentSchema returns the schema referring to the component schema of the DTO, which is added if not yet
+func (doc *Document) contentSchema(dto interface{}, array bool) (*Schema, errors.EdgeX) {
+	t := reflect.TypeOf(dto)
+	var s *Schema
+	switch t.Kind() {
+	case reflect.Struct:
+		name := t.Name()
+		if _, ok := doc.Components.Schemas[name]; !ok {
+			component, err := jsonschema.Generate(dto)
+			if err != nil {
+				return nil, errors.NewCommonEdgeXWrapper(err)
+			}
+			// The dialect is declared by the OpenAPI document
+			component.Schema = ""
+			doc.Components.Schemas[name] = component
+		}
+		s = &Schema{Ref: componentSchemasRef + name}
+	case reflect.Map:
+		if t.Key().Kind() != reflect.String || t.Elem().Kind() != reflect.String {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the content type %v is not supported", t), nil)
+		}
+		s = &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the content type %v is not supported", t), nil)
+	}
+	if array {
+		return &Schema{Type: "array", Items: s}, nil
+	}
+	return s, nil
+}
+
+// tag groups the operations by the first segment of the path, e.g. "device" of /api/v2/device/all
+func tag(path string) string {
+	return strings.Split(strings.TrimPrefix(path, v2.ApiBase+"/"), "/")[0]
+}
+
+func pathParameterSchema(name string) *Schema {
+	switch name {
+	case v2.Start, v2.End, v2.Age:
+		return &Schema{Type: "integer", Format: "int64", Minimum: intPtr(0)}
+	default:
+		return &Schema{Type: "string"}
+	}
+}
+
+// queryParameters are the query parameters shared by the routes
+var queryParameters = map[string]Parameter{
+	v2.Offset: {
+		Name: v2.Offset, In: "query", Description: "The number of items to skip before starting to collect the result set",
+		Schema: &Schema{Type: "integer", Minimum: intPtr(0), Default: v2.DefaultOffset},
+	},
+	v2.Limit: {
+		Name: v2.Limit, In: "query", Description: "The number of items to return, where -1 returns all the items",
+		Schema: &Schema{Type: "integer", Minimum: intPtr(-1), Default: v2.DefaultLimit},
+	},
+	v2.Labels: {
+		Name: v2.Labels, In: "query", Description: "The comma-separated labels which the items should have",
+		Schema: &Schema{Type: "string"},
+	},
+	v2.PushEvent: {
+		Name: v2.PushEvent, In: "query", Description: "Whether the device service pushes the event to core-data",
+		Schema: &Schema{Type: "string", Enum: []string{v2.ValueYes, v2.ValueNo}, Default: v2.ValueNo},
+	},
+	v2.ReturnEvent: {
+		Name: v2.ReturnEvent, In: "query", Description: "Whether the device service returns the event",
+		Schema: &Schema{Type: "string", Enum: []string{v2.ValueYes, v2.ValueNo}, Default: v2.ValueYes},
+	},
+}
+
+func intPtr(i int) *int {
+	return &i
+}
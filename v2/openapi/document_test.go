@@ -0,0 +1,148 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerate(t *testing.T) {
+	for _, service := range Services() {
+		t.Run(service, func(t *testing.T) {
+			doc, err := Generate(service, "2.0.0")
+			require.NoError(t, err)
+			assert.Equal(t, Version, doc.OpenAPI)
+			assert.Equal(t, service, doc.Info.Title)
+			assert.Contains(t, doc.Paths, "/ping")
+
+			// Every reference should resolve to a component schema
+			data, jsonErr := json.Marshal(doc)
+			require.NoError(t, jsonErr)
+			for _, part := range strings.Split(string(data), `"$ref":"`)[1:] {
+				ref := part[:strings.Index(part, `"`)]
+				require.True(t, strings.HasPrefix(ref, componentSchemasRef), ref)
+				assert.Contains(t, doc.Components.Schemas, strings.TrimPrefix(ref, componentSchemasRef))
+			}
+			for _, schema := range doc.Components.Schemas {
+				assert.Empty(t, schema.Schema)
+			}
+		})
+	}
+
+	_, err := Generate("unknown", "2.0.0")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindEntityDoesNotExist, errors.Kind(err))
+}
+
+func TestGenerate_Operations(t *testing.T) {
+	metadata, err := Generate(ServiceCoreMetadata, "2.0.0")
+	require.NoError(t, err)
+
+	allDevices := metadata.Paths["/device/all"]["get"]
+	require.NotNil(t, allDevices)
+	assert.Equal(t, "AllDevices", allDevices.OperationId)
+	assert.Equal(t, []string{"device"}, allDevices.Tags)
+	var names []string
+	for _, p := range allDevices.Parameters {
+		assert.Equal(t, "query", p.In)
+		names = append(names, p.Name)
+	}
+	assert.Equal(t, []string{v2.Offset, v2.Limit, v2.Labels}, names)
+	assert.Equal(t, v2.DefaultLimit, allDevices.Parameters[1].Schema.Default)
+	assert.Nil(t, allDevices.RequestBody)
+	assert.Equal(t, componentSchemasRef+"MultiDevicesResponse", allDevices.Responses["200"].Content["application/json"].Schema.Ref)
+	assert.Equal(t, componentSchemasRef+"BaseResponse", allDevices.Responses["default"].Content["application/json"].Schema.Ref)
+
+	addDevices := metadata.Paths["/device"]["post"]
+	require.NotNil(t, addDevices)
+	body := addDevices.RequestBody.Content["application/json"].Schema
+	assert.Equal(t, "array", body.Type)
+	assert.Equal(t, componentSchemasRef+"AddDeviceRequest", body.Items.Ref)
+	assert.Equal(t, "array", addDevices.Responses["207"].Content["application/json"].Schema.Type)
+
+	byManufacturerAndModel := metadata.Paths["/deviceprofile/manufacturer/{manufacturer}/model/{model}"]["get"]
+	require.NotNil(t, byManufacturerAndModel)
+	assert.Equal(t, Parameter{Name: v2.Manufacturer, In: "path", Required: true, Schema: &Schema{Type: "string"}}, byManufacturerAndModel.Parameters[0])
+	assert.Equal(t, v2.Model, byManufacturerAndModel.Parameters[1].Name)
+
+	upload := metadata.Paths["/deviceprofile/uploadfile"]["post"]
+	require.NotNil(t, upload)
+	assert.Contains(t, upload.RequestBody.Content, contentTypeMultipart)
+
+	command, err := Generate(ServiceCoreCommand, "2.0.0")
+	require.NoError(t, err)
+	assert.Equal(t, componentSchemasRef+"MultiDeviceCoreCommandsResponse", command.Paths["/device/all"]["get"].Responses["200"].Content["application/json"].Schema.Ref)
+	set := command.Paths["/device/name/{name}/{command}"]["put"]
+	require.NotNil(t, set)
+	assert.Equal(t, &Schema{Type: "object", AdditionalProperties: &Schema{Type: "string"}}, set.RequestBody.Content["application/json"].Schema)
+	get := command.Paths["/device/name/{name}/{command}"]["get"]
+	require.Len(t, get.Parameters, 4)
+	assert.Equal(t, []string{v2.ValueYes, v2.ValueNo}, get.Parameters[2].Schema.Enum)
+
+	data, err := Generate(ServiceCoreData, "2.0.0")
+	require.NoError(t, err)
+	byTimeRange := data.Paths["/event/start/{start}/end/{end}"]["get"]
+	require.NotNil(t, byTimeRange)
+	assert.Equal(t, "integer", byTimeRange.Parameters[0].Schema.Type)
+	assert.Equal(t, "integer", byTimeRange.Parameters[1].Schema.Type)
+}
+
+func TestRoutes(t *testing.T) {
+	services := map[string]bool{"": true}
+	for _, s := range Services() {
+		services[s] = true
+	}
+	for _, r := range Routes {
+		t.Run(r.Name, func(t *testing.T) {
+			assert.NoError(t, r.validate())
+			assert.True(t, services[r.Service], "unknown service %s", r.Service)
+			for _, name := range r.Query {
+				assert.Contains(t, queryParameters, name)
+			}
+		})
+	}
+}
+
+func TestNewDocument_Errors(t *testing.T) {
+	valid := Route{Name: "DeviceByName", Method: http.MethodGet, Path: v2.ApiDeviceByNameRoute, Response: responses.DeviceResponse{}}
+
+	tests := []struct {
+		name   string
+		routes []Route
+	}{
+		{"no name", []Route{{Method: http.MethodGet, Path: v2.ApiPingRoute, Response: common.PingResponse{}}}},
+		{"invalid method", []Route{{Name: "Ping", Method: http.MethodHead, Path: v2.ApiPingRoute, Response: common.PingResponse{}}}},
+		{"path outside of the API", []Route{{Name: "Ping", Method: http.MethodGet, Path: "/ping", Response: common.PingResponse{}}}},
+		{"empty placeholder", []Route{{Name: "Device", Method: http.MethodGet, Path: v2.ApiDeviceRoute + "/{}", Response: responses.DeviceResponse{}}}},
+		{"unbalanced placeholder", []Route{{Name: "Device", Method: http.MethodGet, Path: v2.ApiDeviceRoute + "/{name", Response: responses.DeviceResponse{}}}},
+		{"partial placeholder", []Route{{Name: "Device", Method: http.MethodGet, Path: v2.ApiDeviceRoute + "/name-{name}", Response: responses.DeviceResponse{}}}},
+		{"upload and request", []Route{{Name: "Upload", Method: http.MethodPost, Path: v2.ApiDeviceProfileUploadFileRoute, Upload: true, Request: requests.DeviceProfileRequest{}, Response: common.BaseResponse{}}}},
+		{"no response", []Route{{Name: "Ping", Method: http.MethodGet, Path: v2.ApiPingRoute}}},
+		{"unknown query parameter", []Route{{Name: "Ping", Method: http.MethodGet, Path: v2.ApiPingRoute, Query: []string{"unknown"}, Response: common.PingResponse{}}}},
+		{"unsupported content", []Route{{Name: "Ping", Method: http.MethodGet, Path: v2.ApiPingRoute, Response: "pong"}}},
+		{"duplicate name", []Route{valid, {Name: valid.Name, Method: http.MethodDelete, Path: v2.ApiDeviceByNameRoute, Response: common.BaseResponse{}}}},
+		{"duplicate method and path", []Route{valid, {Name: "DeviceByName2", Method: http.MethodGet, Path: v2.ApiDeviceByNameRoute, Response: responses.DeviceResponse{}}}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := NewDocument(Info{Title: "test", Version: "1"}, testCase.routes)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
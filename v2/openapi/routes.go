@@ -0,0 +1,273 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+)
+
+// Constants for the services serving the routes
+const (
+	ServiceCoreData             = "core-data"
+	ServiceCoreMetadata         = "core-metadata"
+	ServiceCoreCommand          = "core-command"
+	ServiceSupportNotifications = "support-notifications"
+	ServiceSupportScheduler     = "support-scheduler"
+	ServiceSystemManagement     = "sys-mgmt-agent"
+	ServiceDevice               = "device-service"
+)
+
+// Services returns the names of the services in the route table
+func Services() []string {
+	return []string{
+		ServiceCoreData,
+		ServiceCoreMetadata,
+		ServiceCoreCommand,
+		ServiceSupportNotifications,
+		ServiceSupportScheduler,
+		ServiceSystemManagement,
+		ServiceDevice,
+	}
+}
+
+// Route describes an operation served on one of the Api*Route paths. The path parameters are taken from the {name}
+// segments of the path, and the DTOs are described by their component schemas.
+type Route struct {
+	// Service is the service serving the route, or empty for the routes served by all the services
+	Service string
+	// Name is the operationId of the route, which is the name of the client method if any
+	Name    string
+	Summary string
+	Method  string
+	Path    string
+	// Query are the names of the supported query parameters, e.g. v2.Offset and v2.Limit
+	Query []string
+	// Request is the request DTO, and RequestArray tells whether the body is an array of the DTOs
+	Request      interface{}
+	RequestArray bool
+	// Upload tells whether the body is a multipart form with the YAML file instead of the Request DTO
+	Upload bool
+	// Response is the response DTO of the StatusCode, which is http.StatusOK if zero
+	Response      interface{}
+	ResponseArray bool
+	StatusCode    int
+}
+
+var methods = map[string]bool{
+	http.MethodGet:    true,
+	http.MethodPost:   true,
+	http.MethodPut:    true,
+	http.MethodPatch:  true,
+	http.MethodDelete: true,
+}
+
+func (r Route) validate() errors.EdgeX {
+	if r.Name == "" {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the route %s %s has no name", r.Method, r.Path), nil)
+	}
+	if !methods[r.Method] {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the route %s has the invalid method %s", r.Name, r.Method), nil)
+	}
+	if !strings.HasPrefix(r.Path, v2.ApiBase+"/") {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the path of the route %s should start with %s", r.Name, v2.ApiBase), nil)
+	}
+	for _, segment := range strings.Split(r.Path, "/") {
+		open, close := strings.Count(segment, "{"), strings.Count(segment, "}")
+		if open+close == 0 {
+			continue
+		}
+		if open != 1 || close != 1 || !strings.HasPrefix(segment, "{") || !strings.HasSuffix(segment, "}") || len(segment) == 2 {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the route %s has the invalid path segment %s", r.Name, segment), nil)
+		}
+	}
+	if r.Upload && r.Request != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the route %s should not have both the upload and the request body", r.Name), nil)
+	}
+	if r.Response == nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the route %s has no response", r.Name), nil)
+	}
+	return nil
+}
+
+// ServiceRoutes returns the routes of the service in the route table, including the routes served by all the services
+func ServiceRoutes(service string) []Route {
+	var routes []Route
+	for _, r := range Routes {
+		if r.Service == "" || r.Service == service {
+			routes = append(routes, r)
+		}
+	}
+	return routes
+}
+
+// Generate builds the OpenAPI document of the service from the route table
+func Generate(service string, version string) (*Document, errors.EdgeX) {
+	known := false
+	for _, s := range Services() {
+		known = known || s == service
+	}
+	if !known {
+		return nil, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("service %s does not exist", service), nil)
+	}
+	doc, err := NewDocument(Info{Title: service, Version: version}, ServiceRoutes(service))
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to generate the OpenAPI document of %s", service), err)
+	}
+	return doc, nil
+}
+
+var (
+	paging        = []string{v2.Offset, v2.Limit}
+	labeledPaging = []string{v2.Offset, v2.Limit, v2.Labels}
+)
+
+// Routes is the route table of the v2 services
+var Routes = []Route{
+	// Common
+	{Name: "FetchConfiguration", Summary: "Get the configuration of the service", Method: http.MethodGet, Path: v2.ApiConfigRoute, Response: common.ConfigResponse{}},
+	{Name: "FetchMetrics", Summary: "Get the metrics of the service", Method: http.MethodGet, Path: v2.ApiMetricsRoute, Response: common.MetricsResponse{}},
+	{Name: "Ping", Summary: "Check whether the service is running", Method: http.MethodGet, Path: v2.ApiPingRoute, Response: common.PingResponse{}},
+	{Name: "Version", Summary: "Get the version of the service", Method: http.MethodGet, Path: v2.ApiVersionRoute, Response: common.VersionResponse{}},
+	{Name: "AddSecret", Summary: "Store a secret of the service", Method: http.MethodPost, Path: v2.ApiSecretRoute, Request: common.SecretRequest{}, Response: common.BaseResponse{}, StatusCode: http.StatusCreated},
+
+	// core-data
+	{Service: ServiceCoreData, Name: "AddEvent", Summary: "Add an event", Method: http.MethodPost, Path: v2.ApiEventProfileNameDeviceNameSourceNameRoute, Request: requests.AddEventRequest{}, Response: common.BaseWithIdResponse{}, StatusCode: http.StatusCreated},
+	{Service: ServiceCoreData, Name: "EventById", Summary: "Get an event", Method: http.MethodGet, Path: v2.ApiEventIdRoute, Response: responses.EventResponse{}},
+	{Service: ServiceCoreData, Name: "DeleteEventById", Summary: "Delete an event", Method: http.MethodDelete, Path: v2.ApiEventIdRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreData, Name: "AllEvents", Summary: "Get the events", Method: http.MethodGet, Path: v2.ApiAllEventRoute, Query: paging, Response: responses.MultiEventsResponse{}},
+	{Service: ServiceCoreData, Name: "EventCount", Summary: "Count the events", Method: http.MethodGet, Path: v2.ApiEventCountRoute, Response: common.CountResponse{}},
+	{Service: ServiceCoreData, Name: "EventCountByDeviceName", Summary: "Count the events of a device", Method: http.MethodGet, Path: v2.ApiEventCountByDeviceNameRoute, Response: common.CountResponse{}},
+	{Service: ServiceCoreData, Name: "EventsByDeviceName", Summary: "Get the events of a device", Method: http.MethodGet, Path: v2.ApiEventByDeviceNameRoute, Query: paging, Response: responses.MultiEventsResponse{}},
+	{Service: ServiceCoreData, Name: "DeleteByDeviceName", Summary: "Delete the events of a device", Method: http.MethodDelete, Path: v2.ApiEventByDeviceNameRoute, Response: common.BaseResponse{}, StatusCode: http.StatusAccepted},
+	{Service: ServiceCoreData, Name: "EventsByTimeRange", Summary: "Get the events created in a time range", Method: http.MethodGet, Path: v2.ApiEventByTimeRangeRoute, Query: paging, Response: responses.MultiEventsResponse{}},
+	{Service: ServiceCoreData, Name: "DeleteByAge", Summary: "Delete the events older than the age", Method: http.MethodDelete, Path: v2.ApiEventByAgeRoute, Response: common.BaseResponse{}, StatusCode: http.StatusAccepted},
+	{Service: ServiceCoreData, Name: "AllReadings", Summary: "Get the readings", Method: http.MethodGet, Path: v2.ApiAllReadingRoute, Query: paging, Response: responses.MultiReadingsResponse{}},
+	{Service: ServiceCoreData, Name: "ReadingCount", Summary: "Count the readings", Method: http.MethodGet, Path: v2.ApiReadingCountRoute, Response: common.CountResponse{}},
+	{Service: ServiceCoreData, Name: "ReadingCountByDeviceName", Summary: "Count the readings of a device", Method: http.MethodGet, Path: v2.ApiReadingCountByDeviceNameRoute, Response: common.CountResponse{}},
+	{Service: ServiceCoreData, Name: "ReadingsByDeviceName", Summary: "Get the readings of a device", Method: http.MethodGet, Path: v2.ApiReadingByDeviceNameRoute, Query: paging, Response: responses.MultiReadingsResponse{}},
+	{Service: ServiceCoreData, Name: "ReadingsByResourceName", Summary: "Get the readings of a device resource", Method: http.MethodGet, Path: v2.ApiReadingByResourceNameRoute, Query: paging, Response: responses.MultiReadingsResponse{}},
+	{Service: ServiceCoreData, Name: "ReadingsByTimeRange", Summary: "Get the readings created in a time range", Method: http.MethodGet, Path: v2.ApiReadingByTimeRangeRoute, Query: paging, Response: responses.MultiReadingsResponse{}},
+
+	// core-metadata
+	{Service: ServiceCoreMetadata, Name: "AddDevices", Summary: "Add devices", Method: http.MethodPost, Path: v2.ApiDeviceRoute, Request: requests.AddDeviceRequest{}, RequestArray: true, Response: common.BaseWithIdResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceCoreMetadata, Name: "UpdateDevices", Summary: "Update devices", Method: http.MethodPatch, Path: v2.ApiDeviceRoute, Request: requests.UpdateDeviceRequest{}, RequestArray: true, Response: common.BaseResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceCoreMetadata, Name: "AllDevices", Summary: "Get the devices", Method: http.MethodGet, Path: v2.ApiAllDeviceRoute, Query: labeledPaging, Response: responses.MultiDevicesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceNameExists", Summary: "Check whether a device exists", Method: http.MethodGet, Path: v2.ApiDeviceNameExistsRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceIdExists", Summary: "Check whether a device exists by id", Method: http.MethodGet, Path: v2.ApiDeviceIdExistsRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceByName", Summary: "Get a device", Method: http.MethodGet, Path: v2.ApiDeviceByNameRoute, Response: responses.DeviceResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeleteDeviceByName", Summary: "Delete a device", Method: http.MethodDelete, Path: v2.ApiDeviceByNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceById", Summary: "Get a device by id", Method: http.MethodGet, Path: v2.ApiDeviceByIdRoute, Response: responses.DeviceResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeleteDeviceById", Summary: "Delete a device by id", Method: http.MethodDelete, Path: v2.ApiDeviceByIdRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DevicesByProfileName", Summary: "Get the devices of a device profile", Method: http.MethodGet, Path: v2.ApiDeviceByProfileNameRoute, Query: paging, Response: responses.MultiDevicesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DevicesByProfileId", Summary: "Get the devices of a device profile by id", Method: http.MethodGet, Path: v2.ApiDeviceByProfileIdRoute, Query: paging, Response: responses.MultiDevicesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DevicesByServiceName", Summary: "Get the devices of a device service", Method: http.MethodGet, Path: v2.ApiDeviceByServiceNameRoute, Query: paging, Response: responses.MultiDevicesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DevicesByServiceId", Summary: "Get the devices of a device service by id", Method: http.MethodGet, Path: v2.ApiDeviceByServiceIdRoute, Query: paging, Response: responses.MultiDevicesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "AddDeviceProfiles", Summary: "Add device profiles", Method: http.MethodPost, Path: v2.ApiDeviceProfileRoute, Request: requests.DeviceProfileRequest{}, RequestArray: true, Response: common.BaseWithIdResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceCoreMetadata, Name: "UpdateDeviceProfiles", Summary: "Update device profiles", Method: http.MethodPut, Path: v2.ApiDeviceProfileRoute, Request: requests.DeviceProfileRequest{}, RequestArray: true, Response: common.BaseResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceCoreMetadata, Name: "AddDeviceProfileByYaml", Summary: "Add a device profile from a YAML file", Method: http.MethodPost, Path: v2.ApiDeviceProfileUploadFileRoute, Upload: true, Response: common.BaseWithIdResponse{}, StatusCode: http.StatusCreated},
+	{Service: ServiceCoreMetadata, Name: "UpdateDeviceProfileByYaml", Summary: "Update a device profile from a YAML file", Method: http.MethodPut, Path: v2.ApiDeviceProfileUploadFileRoute, Upload: true, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "AllDeviceProfiles", Summary: "Get the device profiles", Method: http.MethodGet, Path: v2.ApiAllDeviceProfileRoute, Query: labeledPaging, Response: responses.MultiDeviceProfilesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceProfileByName", Summary: "Get a device profile", Method: http.MethodGet, Path: v2.ApiDeviceProfileByNameRoute, Response: responses.DeviceProfileResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeleteDeviceProfileByName", Summary: "Delete a device profile", Method: http.MethodDelete, Path: v2.ApiDeviceProfileByNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceProfileById", Summary: "Get a device profile by id", Method: http.MethodGet, Path: v2.ApiDeviceProfileByIdRoute, Response: responses.DeviceProfileResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeleteDeviceProfileById", Summary: "Delete a device profile by id", Method: http.MethodDelete, Path: v2.ApiDeviceProfileByIdRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceProfilesByManufacturer", Summary: "Get the device profiles of a manufacturer", Method: http.MethodGet, Path: v2.ApiDeviceProfileByManufacturerRoute, Query: paging, Response: responses.MultiDeviceProfilesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceProfilesByModel", Summary: "Get the device profiles of a model", Method: http.MethodGet, Path: v2.ApiDeviceProfileByModelRoute, Query: paging, Response: responses.MultiDeviceProfilesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceProfilesByManufacturerAndModel", Summary: "Get the device profiles of a manufacturer and model", Method: http.MethodGet, Path: v2.ApiDeviceProfileByManufacturerAndModelRoute, Query: paging, Response: responses.MultiDeviceProfilesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceResourceByProfileNameAndResourceName", Summary: "Get a device resource of a device profile", Method: http.MethodGet, Path: v2.ApiDeviceResourceByProfileAndResourceRoute, Response: responses.DeviceResourceResponse{}},
+	{Service: ServiceCoreMetadata, Name: "AddDeviceServices", Summary: "Add device services", Method: http.MethodPost, Path: v2.ApiDeviceServiceRoute, Request: requests.AddDeviceServiceRequest{}, RequestArray: true, Response: common.BaseWithIdResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceCoreMetadata, Name: "UpdateDeviceServices", Summary: "Update device services", Method: http.MethodPatch, Path: v2.ApiDeviceServiceRoute, Request: requests.UpdateDeviceServiceRequest{}, RequestArray: true, Response: common.BaseResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceCoreMetadata, Name: "AllDeviceServices", Summary: "Get the device services", Method: http.MethodGet, Path: v2.ApiAllDeviceServiceRoute, Query: labeledPaging, Response: responses.MultiDeviceServicesResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceServiceByName", Summary: "Get a device service", Method: http.MethodGet, Path: v2.ApiDeviceServiceByNameRoute, Response: responses.DeviceServiceResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeleteDeviceServiceByName", Summary: "Delete a device service", Method: http.MethodDelete, Path: v2.ApiDeviceServiceByNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeviceServiceById", Summary: "Get a device service by id", Method: http.MethodGet, Path: v2.ApiDeviceServiceByIdRoute, Response: responses.DeviceServiceResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeleteDeviceServiceById", Summary: "Delete a device service by id", Method: http.MethodDelete, Path: v2.ApiDeviceServiceByIdRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "AddProvisionWatchers", Summary: "Add provision watchers", Method: http.MethodPost, Path: v2.ApiProvisionWatcherRoute, Request: requests.AddProvisionWatcherRequest{}, RequestArray: true, Response: common.BaseWithIdResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceCoreMetadata, Name: "UpdateProvisionWatchers", Summary: "Update provision watchers", Method: http.MethodPatch, Path: v2.ApiProvisionWatcherRoute, Request: requests.UpdateProvisionWatcherRequest{}, RequestArray: true, Response: common.BaseResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceCoreMetadata, Name: "AllProvisionWatchers", Summary: "Get the provision watchers", Method: http.MethodGet, Path: v2.ApiAllProvisionWatcherRoute, Query: labeledPaging, Response: responses.MultiProvisionWatchersResponse{}},
+	{Service: ServiceCoreMetadata, Name: "ProvisionWatcherByName", Summary: "Get a provision watcher", Method: http.MethodGet, Path: v2.ApiProvisionWatcherByNameRoute, Response: responses.ProvisionWatcherResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeleteProvisionWatcherByName", Summary: "Delete a provision watcher", Method: http.MethodDelete, Path: v2.ApiProvisionWatcherByNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "ProvisionWatcherById", Summary: "Get a provision watcher by id", Method: http.MethodGet, Path: v2.ApiProvisionWatcherByIdRoute, Response: responses.ProvisionWatcherResponse{}},
+	{Service: ServiceCoreMetadata, Name: "DeleteProvisionWatcherById", Summary: "Delete a provision watcher by id", Method: http.MethodDelete, Path: v2.ApiProvisionWatcherByIdRoute, Response: common.BaseResponse{}},
+	{Service: ServiceCoreMetadata, Name: "ProvisionWatchersByProfileName", Summary: "Get the provision watchers of a device profile", Method: http.MethodGet, Path: v2.ApiProvisionWatcherByProfileNameRoute, Query: paging, Response: responses.MultiProvisionWatchersResponse{}},
+	{Service: ServiceCoreMetadata, Name: "ProvisionWatchersByServiceName", Summary: "Get the provision watchers of a device service", Method: http.MethodGet, Path: v2.ApiProvisionWatcherByServiceNameRoute, Query: paging, Response: responses.MultiProvisionWatchersResponse{}},
+
+	// core-command
+	{Service: ServiceCoreCommand, Name: "AllDeviceCoreCommands", Summary: "Get the commands of the devices", Method: http.MethodGet, Path: v2.ApiAllDeviceRoute, Query: paging, Response: responses.MultiDeviceCoreCommandsResponse{}},
+	{Service: ServiceCoreCommand, Name: "DeviceCoreCommandsByDeviceName", Summary: "Get the commands of a device", Method: http.MethodGet, Path: v2.ApiDeviceByNameRoute, Response: responses.DeviceCoreCommandResponse{}},
+	{Service: ServiceCoreCommand, Name: "IssueGetCommandByName", Summary: "Issue a get command to a device", Method: http.MethodGet, Path: v2.ApiDeviceNameCommandNameRoute, Query: []string{v2.PushEvent, v2.ReturnEvent}, Response: responses.EventResponse{}},
+	{Service: ServiceCoreCommand, Name: "IssueSetCommandByName", Summary: "Issue a set command to a device", Method: http.MethodPut, Path: v2.ApiDeviceNameCommandNameRoute, Request: map[string]string{}, Response: common.BaseResponse{}},
+
+	// support-notifications
+	{Service: ServiceSupportNotifications, Name: "AddSubscriptions", Summary: "Add subscriptions", Method: http.MethodPost, Path: v2.ApiSubscriptionRoute, Request: requests.AddSubscriptionRequest{}, RequestArray: true, Response: common.BaseWithIdResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceSupportNotifications, Name: "UpdateSubscriptions", Summary: "Update subscriptions", Method: http.MethodPatch, Path: v2.ApiSubscriptionRoute, Request: requests.UpdateSubscriptionRequest{}, RequestArray: true, Response: common.BaseResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceSupportNotifications, Name: "AllSubscriptions", Summary: "Get the subscriptions", Method: http.MethodGet, Path: v2.ApiAllSubscriptionRoute, Query: paging, Response: responses.MultiSubscriptionsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "SubscriptionByName", Summary: "Get a subscription", Method: http.MethodGet, Path: v2.ApiSubscriptionByNameRoute, Response: responses.SubscriptionResponse{}},
+	{Service: ServiceSupportNotifications, Name: "DeleteSubscriptionByName", Summary: "Delete a subscription", Method: http.MethodDelete, Path: v2.ApiSubscriptionByNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceSupportNotifications, Name: "SubscriptionsByCategory", Summary: "Get the subscriptions of a category", Method: http.MethodGet, Path: v2.ApiSubscriptionByCategoryRoute, Query: paging, Response: responses.MultiSubscriptionsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "SubscriptionsByLabel", Summary: "Get the subscriptions of a label", Method: http.MethodGet, Path: v2.ApiSubscriptionByLabelRoute, Query: paging, Response: responses.MultiSubscriptionsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "SubscriptionsByReceiver", Summary: "Get the subscriptions of a receiver", Method: http.MethodGet, Path: v2.ApiSubscriptionByReceiverRoute, Query: paging, Response: responses.MultiSubscriptionsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "AddNotifications", Summary: "Add notifications", Method: http.MethodPost, Path: v2.ApiNotificationRoute, Request: requests.AddNotificationRequest{}, RequestArray: true, Response: common.BaseWithIdResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceSupportNotifications, Name: "NotificationById", Summary: "Get a notification", Method: http.MethodGet, Path: v2.ApiNotificationByIdRoute, Response: responses.NotificationResponse{}},
+	{Service: ServiceSupportNotifications, Name: "DeleteNotificationById", Summary: "Delete a notification", Method: http.MethodDelete, Path: v2.ApiNotificationByIdRoute, Response: common.BaseResponse{}},
+	{Service: ServiceSupportNotifications, Name: "NotificationsByCategory", Summary: "Get the notifications of a category", Method: http.MethodGet, Path: v2.ApiNotificationByCategoryRoute, Query: paging, Response: responses.MultiNotificationsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "NotificationsByLabel", Summary: "Get the notifications of a label", Method: http.MethodGet, Path: v2.ApiNotificationByLabelRoute, Query: paging, Response: responses.MultiNotificationsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "NotificationsByStatus", Summary: "Get the notifications of a status", Method: http.MethodGet, Path: v2.ApiNotificationByStatusRoute, Query: paging, Response: responses.MultiNotificationsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "NotificationsByTimeRange", Summary: "Get the notifications created in a time range", Method: http.MethodGet, Path: v2.ApiNotificationByTimeRangeRoute, Query: paging, Response: responses.MultiNotificationsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "NotificationsBySubscriptionName", Summary: "Get the notifications of a subscription", Method: http.MethodGet, Path: v2.ApiNotificationBySubscriptionNameRoute, Query: paging, Response: responses.MultiNotificationsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "DeleteProcessedNotificationsByAge", Summary: "Delete the processed notifications older than the age", Method: http.MethodDelete, Path: v2.ApiNotificationByAgeRoute, Response: common.BaseResponse{}, StatusCode: http.StatusAccepted},
+	{Service: ServiceSupportNotifications, Name: "CleanupNotifications", Summary: "Delete the notifications and transmissions", Method: http.MethodDelete, Path: v2.ApiNotificationCleanupRoute, Response: common.BaseResponse{}, StatusCode: http.StatusAccepted},
+	{Service: ServiceSupportNotifications, Name: "CleanupNotificationsByAge", Summary: "Delete the notifications and transmissions older than the age", Method: http.MethodDelete, Path: v2.ApiNotificationCleanupByAgeRoute, Response: common.BaseResponse{}, StatusCode: http.StatusAccepted},
+	{Service: ServiceSupportNotifications, Name: "TransmissionById", Summary: "Get a transmission", Method: http.MethodGet, Path: v2.ApiTransmissionByIdRoute, Response: responses.TransmissionResponse{}},
+	{Service: ServiceSupportNotifications, Name: "AllTransmissions", Summary: "Get the transmissions", Method: http.MethodGet, Path: v2.ApiAllTransmissionRoute, Query: paging, Response: responses.MultiTransmissionsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "TransmissionsBySubscriptionName", Summary: "Get the transmissions of a subscription", Method: http.MethodGet, Path: v2.ApiTransmissionBySubscriptionNameRoute, Query: paging, Response: responses.MultiTransmissionsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "TransmissionsByTimeRange", Summary: "Get the transmissions created in a time range", Method: http.MethodGet, Path: v2.ApiTransmissionByTimeRangeRoute, Query: paging, Response: responses.MultiTransmissionsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "TransmissionsByStatus", Summary: "Get the transmissions of a status", Method: http.MethodGet, Path: v2.ApiTransmissionByStatusRoute, Query: paging, Response: responses.MultiTransmissionsResponse{}},
+	{Service: ServiceSupportNotifications, Name: "DeleteProcessedTransmissionsByAge", Summary: "Delete the processed transmissions older than the age", Method: http.MethodDelete, Path: v2.ApiTransmissionByAgeRoute, Response: common.BaseResponse{}, StatusCode: http.StatusAccepted},
+
+	// support-scheduler
+	{Service: ServiceSupportScheduler, Name: "AddIntervals", Summary: "Add intervals", Method: http.MethodPost, Path: v2.ApiIntervalRoute, Request: requests.AddIntervalRequest{}, RequestArray: true, Response: common.BaseWithIdResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceSupportScheduler, Name: "UpdateIntervals", Summary: "Update intervals", Method: http.MethodPatch, Path: v2.ApiIntervalRoute, Request: requests.UpdateIntervalRequest{}, RequestArray: true, Response: common.BaseResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceSupportScheduler, Name: "AllIntervals", Summary: "Get the intervals", Method: http.MethodGet, Path: v2.ApiAllIntervalRoute, Query: paging, Response: responses.MultiIntervalsResponse{}},
+	{Service: ServiceSupportScheduler, Name: "IntervalByName", Summary: "Get an interval", Method: http.MethodGet, Path: v2.ApiIntervalByNameRoute, Response: responses.IntervalResponse{}},
+	{Service: ServiceSupportScheduler, Name: "DeleteIntervalByName", Summary: "Delete an interval", Method: http.MethodDelete, Path: v2.ApiIntervalByNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceSupportScheduler, Name: "AddIntervalActions", Summary: "Add interval actions", Method: http.MethodPost, Path: v2.ApiIntervalActionRoute, Request: requests.AddIntervalActionRequest{}, RequestArray: true, Response: common.BaseWithIdResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceSupportScheduler, Name: "UpdateIntervalActions", Summary: "Update interval actions", Method: http.MethodPatch, Path: v2.ApiIntervalActionRoute, Request: requests.UpdateIntervalActionRequest{}, RequestArray: true, Response: common.BaseResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceSupportScheduler, Name: "AllIntervalActions", Summary: "Get the interval actions", Method: http.MethodGet, Path: v2.ApiAllIntervalActionRoute, Query: paging, Response: responses.MultiIntervalActionsResponse{}},
+	{Service: ServiceSupportScheduler, Name: "IntervalActionByName", Summary: "Get an interval action", Method: http.MethodGet, Path: v2.ApiIntervalActionByNameRoute, Response: responses.IntervalActionResponse{}},
+	{Service: ServiceSupportScheduler, Name: "DeleteIntervalActionByName", Summary: "Delete an interval action", Method: http.MethodDelete, Path: v2.ApiIntervalActionByNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceSupportScheduler, Name: "IntervalActionsByTarget", Summary: "Get the interval actions of a target", Method: http.MethodGet, Path: v2.ApiIntervalActionByTargetRoute, Query: paging, Response: responses.MultiIntervalActionsResponse{}},
+
+	// sys-mgmt-agent
+	{Service: ServiceSystemManagement, Name: "IssueOperation", Summary: "Start, stop or restart a service", Method: http.MethodPost, Path: v2.ApiOperationRoute, Request: requests.OperationRequest{}, RequestArray: true, Response: common.BaseResponse{}, ResponseArray: true, StatusCode: http.StatusMultiStatus},
+	{Service: ServiceSystemManagement, Name: "Health", Summary: "Get the health of the services", Method: http.MethodGet, Path: v2.ApiHealthRoute, Response: responses.HealthResponse{}},
+	{Service: ServiceSystemManagement, Name: "MultiMetrics", Summary: "Get the metrics of the services", Method: http.MethodGet, Path: v2.ApiMultiMetricsRoute, Response: common.MultiMetricsResponse{}},
+	{Service: ServiceSystemManagement, Name: "MultiConfigs", Summary: "Get the configurations of the services", Method: http.MethodGet, Path: v2.ApiMultiConfigsRoute, Response: common.MultiConfigsResponse{}},
+
+	// device-service
+	{Service: ServiceDevice, Name: "AddDeviceCallback", Summary: "Notify that a device is added", Method: http.MethodPost, Path: v2.ApiDeviceCallbackRoute, Request: requests.AddDeviceRequest{}, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "UpdateDeviceCallback", Summary: "Notify that a device is updated", Method: http.MethodPut, Path: v2.ApiDeviceCallbackRoute, Request: requests.UpdateDeviceRequest{}, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "DeleteDeviceCallback", Summary: "Notify that a device is deleted", Method: http.MethodDelete, Path: v2.ApiDeviceCallbackNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "UpdateDeviceProfileCallback", Summary: "Notify that a device profile is updated", Method: http.MethodPut, Path: v2.ApiProfileCallbackRoute, Request: requests.DeviceProfileRequest{}, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "DeleteDeviceProfileCallback", Summary: "Notify that a device profile is deleted", Method: http.MethodDelete, Path: v2.ApiProfileCallbackNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "AddProvisionWatcherCallback", Summary: "Notify that a provision watcher is added", Method: http.MethodPost, Path: v2.ApiWatcherCallbackRoute, Request: requests.AddProvisionWatcherRequest{}, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "UpdateProvisionWatcherCallback", Summary: "Notify that a provision watcher is updated", Method: http.MethodPut, Path: v2.ApiWatcherCallbackRoute, Request: requests.UpdateProvisionWatcherRequest{}, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "DeleteProvisionWatcherCallback", Summary: "Notify that a provision watcher is deleted", Method: http.MethodDelete, Path: v2.ApiWatcherCallbackNameRoute, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "UpdateDeviceServiceCallback", Summary: "Notify that the device service is updated", Method: http.MethodPut, Path: v2.ApiServiceCallbackRoute, Request: requests.UpdateDeviceServiceRequest{}, Response: common.BaseResponse{}},
+	{Service: ServiceDevice, Name: "TriggerDiscovery", Summary: "Trigger the device discovery", Method: http.MethodPost, Path: v2.ApiDiscoveryRoute, Request: requests.DiscoveryRequest{}, Response: responses.DiscoveryResponse{}, StatusCode: http.StatusAccepted},
+	{Service: ServiceDevice, Name: "DiscoveryById", Summary: "Get the state of a discovery", Method: http.MethodGet, Path: v2.ApiDiscoveryByIdRoute, Response: responses.DiscoveryResponse{}},
+	{Service: ServiceDevice, Name: "DiscoveredDevices", Summary: "Get the devices found by a discovery", Method: http.MethodGet, Path: v2.ApiDiscoveredDeviceRoute, Query: paging, Response: responses.MultiDiscoveredDevicesResponse{}},
+	{Service: ServiceDevice, Name: "GetCommand", Summary: "Read a command of a device", Method: http.MethodGet, Path: v2.ApiDeviceNameCommandNameRoute, Query: []string{v2.PushEvent, v2.ReturnEvent}, Response: responses.EventResponse{}},
+	{Service: ServiceDevice, Name: "SetCommand", Summary: "Write a command of a device", Method: http.MethodPut, Path: v2.ApiDeviceNameCommandNameRoute, Request: map[string]string{}, Response: common.BaseResponse{}},
+}
@@ -0,0 +1,68 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package openapi
+
+import (
+	"go/ast"
+	"go/constant"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// unservedRoutes are the Api*Route constants which are not in the route table, since they are only the prefixes of
+// the served paths
+var unservedRoutes = map[string]bool{
+	"ApiEventRoute":          true,
+	"ApiReadingRoute":        true,
+	"ApiDeviceResourceRoute": true,
+	"ApiTransmissionRoute":   true,
+}
+
+var apiRouteName = regexp.MustCompile(`^Api\w*Route$`)
+
+// apiRoutes type-checks the route constants of the v2 package and returns the values of the Api*Route constants
+func apiRoutes(t *testing.T) map[string]string {
+	fset := token.NewFileSet()
+	file, err := parser.ParseFile(fset, "../constants.go", nil, 0)
+	require.NoError(t, err)
+	pkg, err := (&types.Config{Importer: importer.Default()}).Check("v2", fset, []*ast.File{file}, nil)
+	require.NoError(t, err)
+
+	routes := make(map[string]string)
+	for _, name := range pkg.Scope().Names() {
+		c, ok := pkg.Scope().Lookup(name).(*types.Const)
+		if ok && apiRouteName.MatchString(name) {
+			routes[name] = constant.StringVal(c.Val())
+		}
+	}
+	return routes
+}
+
+func TestRoutes_Coverage(t *testing.T) {
+	served := make(map[string]bool)
+	for _, r := range Routes {
+		served[r.Path] = true
+	}
+	routes := apiRoutes(t)
+	require.NotEmpty(t, routes)
+	for name, path := range routes {
+		if unservedRoutes[name] {
+			assert.False(t, served[path], "%s is served, so it should be removed from the unserved routes", name)
+			continue
+		}
+		assert.True(t, served[path], "%s %s is not in the route table", name, path)
+	}
+	for name := range unservedRoutes {
+		assert.Contains(t, routes, name)
+	}
+}
@@ -0,0 +1,289 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package patch applies partial updates to the DTOs and models. A JSON merge patch (RFC 7396) or an Update DTO with an
+// optional field mask is turned into Fields, which tells apart the absent fields, which are left unchanged, from the
+// null fields, which are cleared.
+package patch
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+var null = json.RawMessage("null")
+
+// Fields replaces the top-level JSON fields of a value, where a null field is reset to the zero value and the absent
+// fields are unchanged
+type Fields map[string]json.RawMessage
+
+// Names returns the sorted JSON names of the fields
+func (f Fields) Names() []string {
+	names := make([]string, 0, len(f))
+	for name := range f {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Nulls returns the null fields, which clear the fields
+func (f Fields) Nulls() Fields {
+	nulls := make(Fields)
+	for name, value := range f {
+		if isNull(value) {
+			nulls[name] = value
+		}
+	}
+	return nulls
+}
+
+// ApplyTo replaces the fields of the struct pointed by target, and returns the Go names of the replaced fields, which
+// are promoted names for the fields of the embedded structs. An error is returned if the target has no field of a JSON
+// name or a field value can't be decoded, in which case the target is unchanged.
+func (f Fields) ApplyTo(target interface{}) ([]string, errors.EdgeX) {
+	v := reflect.ValueOf(target)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the patch target should be a pointer to a struct instead of %T", target), nil)
+	}
+	goNames := jsonFields(v.Elem().Type())
+	var names []string
+	document := make(map[string]json.RawMessage, len(f))
+	for _, name := range f.Names() {
+		goName, ok := goNames[name]
+		if !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s has no field %s", v.Elem().Type().Name(), name), nil)
+		}
+		names = append(names, goName)
+		if !isNull(f[name]) {
+			document[name] = f[name]
+		}
+	}
+
+	// The fields are decoded into a zero value, so the null fields, which are not in the document, become zero values
+	data, err := json.Marshal(document)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the patch", err)
+	}
+	patched := reflect.New(v.Elem().Type())
+	if err := json.Unmarshal(data, patched.Interface()); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to decode the patch of %s", v.Elem().Type().Name()), err)
+	}
+	if err := CopyFields(target, patched.Interface(), names); err != nil {
+		return nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	return names, nil
+}
+
+// Apply applies the JSON merge patch to the struct pointed by target, where the JSON objects are merged recursively
+// as RFC 7396 defines, and returns the Go names of the patched fields
+func Apply(target interface{}, mergePatch []byte) ([]string, errors.EdgeX) {
+	var patchFields Fields
+	if err := json.Unmarshal(mergePatch, &patchFields); err != nil || patchFields == nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the merge patch should be a JSON object", err)
+	}
+	current := make(Fields)
+	data, err := json.Marshal(target)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the patch target", err)
+	}
+	if err := json.Unmarshal(data, &current); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the patch target should be encoded as a JSON object", err)
+	}
+
+	fields := make(Fields, len(patchFields))
+	for name, value := range patchFields {
+		original, ok := current[name]
+		if !ok {
+			original = null
+		}
+		merged, err := Merge(original, value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		fields[name] = merged
+	}
+	names, edgexErr := fields.ApplyTo(target)
+	if edgexErr != nil {
+		return nil, errors.NewCommonEdgeXWrapper(edgexErr)
+	}
+	return names, nil
+}
+
+// Merge applies the JSON merge patch to the JSON document as RFC 7396 defines. The members of a patch object are
+// merged into the document object, where a null member removes the member from the document, and any other patch
+// value replaces the document.
+func Merge(document []byte, mergePatch []byte) ([]byte, errors.EdgeX) {
+	var patchObject map[string]json.RawMessage
+	if !isObject(mergePatch) {
+		if !json.Valid(mergePatch) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the merge patch is not valid JSON", nil)
+		}
+		return mergePatch, nil
+	}
+	if err := json.Unmarshal(mergePatch, &patchObject); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the merge patch is not valid JSON", err)
+	}
+
+	documentObject := make(map[string]json.RawMessage)
+	if isObject(document) {
+		if err := json.Unmarshal(document, &documentObject); err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the document is not valid JSON", err)
+		}
+	}
+	for name, value := range patchObject {
+		if isNull(value) {
+			delete(documentObject, name)
+			continue
+		}
+		original, ok := documentObject[name]
+		if !ok {
+			original = null
+		}
+		merged, err := Merge(original, value)
+		if err != nil {
+			return nil, errors.NewCommonEdgeXWrapper(err)
+		}
+		documentObject[name] = merged
+	}
+	result, err := json.Marshal(documentObject)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to encode the merged document", err)
+	}
+	return result, nil
+}
+
+// FromUpdate returns the Fields of the Update DTO, whose pointer, slice, map and interface fields are nil when absent.
+// The nil fields are absent from the Fields unless their JSON names are in the mask, in which case they are null and
+// clear the patched fields. The other non-nil fields, including the empty slices and maps, are in the Fields.
+func FromUpdate(update interface{}, mask ...string) (Fields, errors.EdgeX) {
+	v := reflect.Indirect(reflect.ValueOf(update))
+	if v.Kind() != reflect.Struct {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the update should be a struct instead of %T", update), nil)
+	}
+	goNames := jsonFields(v.Type())
+	masked := make(map[string]bool, len(mask))
+	for _, name := range mask {
+		if _, ok := goNames[name]; !ok {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s has no field %s", v.Type().Name(), name), nil)
+		}
+		masked[name] = true
+	}
+
+	fields := make(Fields)
+	for name, goName := range goNames {
+		field := v.FieldByName(goName)
+		if isNil(field) {
+			if masked[name] {
+				fields[name] = null
+			}
+			continue
+		}
+		value, err := json.Marshal(field.Interface())
+		if err != nil {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("failed to encode the field %s", name), err)
+		}
+		fields[name] = value
+	}
+	return fields, nil
+}
+
+// NullFields returns the sorted JSON names of the null members of the JSON object which are the fields of the struct
+// pointed by or of target, i.e. the fields cleared by the object as a merge patch. The other null members are ignored
+// as the unknown fields are ignored by the JSON decoding.
+func NullFields(object []byte, target interface{}) ([]string, errors.EdgeX) {
+	t := reflect.TypeOf(target)
+	if t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() != reflect.Struct {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the patch target should be a struct instead of %T", target), nil)
+	}
+	var fields Fields
+	if err := json.Unmarshal(object, &fields); err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, "the merge patch should be a JSON object", err)
+	}
+	goNames := jsonFields(t)
+	var names []string
+	for _, name := range fields.Nulls().Names() {
+		if _, ok := goNames[name]; ok {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// CopyFields copies the fields of the Go names from the struct pointed by src to the struct pointed by dst, where both
+// structs are of the same type. The names of the fields of the embedded structs are the promoted names.
+func CopyFields(dst interface{}, src interface{}, names []string) errors.EdgeX {
+	d, s := reflect.ValueOf(dst), reflect.ValueOf(src)
+	if d.Kind() != reflect.Ptr || s.Kind() != reflect.Ptr || d.Elem().Kind() != reflect.Struct || d.Type() != s.Type() {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the fields can't be copied from %T to %T", src, dst), nil)
+	}
+	// The names are checked before copying, so dst is unchanged if any name is invalid
+	for _, name := range names {
+		if field := d.Elem().FieldByName(name); !field.IsValid() || !field.CanSet() {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s has no field %s", d.Elem().Type().Name(), name), nil)
+		}
+	}
+	for _, name := range names {
+		d.Elem().FieldByName(name).Set(s.Elem().FieldByName(name))
+	}
+	return nil
+}
+
+// jsonFields maps the JSON names of the exported fields to their Go names, where the fields of the embedded structs
+// without JSON names are promoted as encoding/json does
+func jsonFields(t reflect.Type) map[string]string {
+	names := make(map[string]string)
+	for i := 0; i < t.NumField(); i++ {
+		field := t.Field(i)
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name := strings.Split(tag, ",")[0]
+		if field.Anonymous && name == "" && field.Type.Kind() == reflect.Struct {
+			for jsonName, goName := range jsonFields(field.Type) {
+				if _, ok := names[jsonName]; !ok {
+					names[jsonName] = goName
+				}
+			}
+			continue
+		}
+		if field.PkgPath != "" {
+			continue
+		}
+		if name == "" {
+			name = field.Name
+		}
+		names[name] = field.Name
+	}
+	return names
+}
+
+func isNil(v reflect.Value) bool {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Slice, reflect.Map, reflect.Interface:
+		return v.IsNil()
+	default:
+		return false
+	}
+}
+
+func isNull(value json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(value), null)
+}
+
+func isObject(value []byte) bool {
+	value = bytes.TrimSpace(value)
+	return len(value) > 0 && value[0] == '{'
+}
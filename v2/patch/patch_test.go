@@ -0,0 +1,240 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package patch
+
+import (
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testBase struct {
+	Created int64 `json:"created,omitempty"`
+}
+
+type testEntity struct {
+	testBase    `json:",inline"`
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Labels      []string          `json:"labels,omitempty"`
+	Properties  map[string]string `json:"properties,omitempty"`
+	Ignored     string            `json:"-"`
+}
+
+type testUpdate struct {
+	Name        *string  `json:"name"`
+	Description *string  `json:"description"`
+	Labels      []string `json:"labels"`
+}
+
+func testEntityData() testEntity {
+	return testEntity{
+		testBase:    testBase{Created: 1},
+		Name:        "entity",
+		Description: "description",
+		Labels:      []string{"a", "b"},
+		Properties:  map[string]string{"k1": "v1", "k2": "v2"},
+		Ignored:     "ignored",
+	}
+}
+
+func TestMerge(t *testing.T) {
+	// The examples of RFC 7396 Appendix A
+	tests := []struct {
+		document string
+		patch    string
+		expected string
+	}{
+		{`{"a":"b"}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"b"}`, `{"b":"c"}`, `{"a":"b","b":"c"}`},
+		{`{"a":"b"}`, `{"a":null}`, `{}`},
+		{`{"a":"b","b":"c"}`, `{"a":null}`, `{"b":"c"}`},
+		{`{"a":["b"]}`, `{"a":"c"}`, `{"a":"c"}`},
+		{`{"a":"c"}`, `{"a":["b"]}`, `{"a":["b"]}`},
+		{`{"a":{"b":"c"}}`, `{"a":{"b":"d","c":null}}`, `{"a":{"b":"d"}}`},
+		{`{"a":[{"b":"c"}]}`, `{"a":[1]}`, `{"a":[1]}`},
+		{`["a","b"]`, `["c","d"]`, `["c","d"]`},
+		{`{"a":"b"}`, `["c"]`, `["c"]`},
+		{`{"a":"foo"}`, `null`, `null`},
+		{`{"a":"foo"}`, `"bar"`, `"bar"`},
+		{`{"e":null}`, `{"a":1}`, `{"a":1,"e":null}`},
+		{`[1,2]`, `{"a":"b","c":null}`, `{"a":"b"}`},
+		{`{}`, `{"a":{"bb":{"ccc":null}}}`, `{"a":{"bb":{}}}`},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.patch, func(t *testing.T) {
+			result, err := Merge([]byte(testCase.document), []byte(testCase.patch))
+			require.NoError(t, err)
+			assert.JSONEq(t, testCase.expected, string(result))
+		})
+	}
+}
+
+func TestMerge_InvalidPatch(t *testing.T) {
+	_, err := Merge([]byte(`{}`), []byte(`{"a":`))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestApply(t *testing.T) {
+	tests := []struct {
+		name          string
+		patch         string
+		expectedNames []string
+		expected      func(*testEntity)
+	}{
+		{"replace field", `{"description":"new"}`, []string{"Description"},
+			func(e *testEntity) { e.Description = "new" }},
+		{"clear field with null", `{"description":null}`, []string{"Description"},
+			func(e *testEntity) { e.Description = "" }},
+		{"clear slice with null", `{"labels":null}`, []string{"Labels"},
+			func(e *testEntity) { e.Labels = nil }},
+		{"replace slice", `{"labels":["c"]}`, []string{"Labels"},
+			func(e *testEntity) { e.Labels = []string{"c"} }},
+		{"merge map", `{"properties":{"k1":null,"k3":"v3"}}`, []string{"Properties"},
+			func(e *testEntity) { e.Properties = map[string]string{"k2": "v2", "k3": "v3"} }},
+		{"embedded field", `{"created":2}`, []string{"Created"},
+			func(e *testEntity) { e.Created = 2 }},
+		{"empty patch", `{}`, nil,
+			func(e *testEntity) {}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			entity := testEntityData()
+			expected := testEntityData()
+			testCase.expected(&expected)
+
+			names, err := Apply(&entity, []byte(testCase.patch))
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expectedNames, names)
+			assert.Equal(t, expected, entity)
+		})
+	}
+}
+
+func TestApply_Errors(t *testing.T) {
+	tests := []struct {
+		name   string
+		target interface{}
+		patch  string
+	}{
+		{"unknown field", &testEntity{}, `{"unknown":"value"}`},
+		{"ignored field", &testEntity{}, `{"Ignored":"value"}`},
+		{"wrong field type", &testEntity{}, `{"labels":"value"}`},
+		{"patch not an object", &testEntity{}, `["value"]`},
+		{"null patch", &testEntity{}, `null`},
+		{"target not a pointer", testEntity{}, `{"name":"value"}`},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := Apply(testCase.target, []byte(testCase.patch))
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestApply_UnchangedOnError(t *testing.T) {
+	entity := testEntityData()
+
+	_, err := Apply(&entity, []byte(`{"description":"new","labels":"value"}`))
+	require.Error(t, err)
+	assert.Equal(t, testEntityData(), entity)
+}
+
+func TestFromUpdate(t *testing.T) {
+	description := "new"
+	tests := []struct {
+		name     string
+		update   testUpdate
+		mask     []string
+		expected Fields
+	}{
+		{"nil fields absent", testUpdate{}, nil, Fields{}},
+		{"non-nil fields", testUpdate{Description: &description, Labels: []string{"c"}}, nil,
+			Fields{"description": []byte(`"new"`), "labels": []byte(`["c"]`)}},
+		{"empty slice", testUpdate{Labels: []string{}}, nil, Fields{"labels": []byte(`[]`)}},
+		{"masked nil fields null", testUpdate{}, []string{"description", "labels"},
+			Fields{"description": null, "labels": null}},
+		{"masked non-nil field", testUpdate{Description: &description}, []string{"description"},
+			Fields{"description": []byte(`"new"`)}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			fields, err := FromUpdate(testCase.update, testCase.mask...)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, fields)
+		})
+	}
+}
+
+func TestFromUpdate_UnknownMask(t *testing.T) {
+	_, err := FromUpdate(testUpdate{}, "unknown")
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestFields_ApplyTo(t *testing.T) {
+	entity := testEntityData()
+	fields, err := FromUpdate(testUpdate{Labels: []string{}}, "description")
+	require.NoError(t, err)
+
+	names, err := fields.ApplyTo(&entity)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Description", "Labels"}, names)
+	assert.Empty(t, entity.Description)
+	assert.Empty(t, entity.Labels)
+	assert.Equal(t, "entity", entity.Name)
+}
+
+func TestCopyFields(t *testing.T) {
+	dst := testEntityData()
+	src := testEntity{testBase: testBase{Created: 2}, Name: "other", Description: "other"}
+
+	err := CopyFields(&dst, &src, []string{"Created", "Description"})
+	require.NoError(t, err)
+	assert.Equal(t, int64(2), dst.Created)
+	assert.Equal(t, "other", dst.Description)
+	assert.Equal(t, "entity", dst.Name)
+}
+
+func TestCopyFields_Errors(t *testing.T) {
+	tests := []struct {
+		name  string
+		dst   interface{}
+		src   interface{}
+		names []string
+	}{
+		{"different types", &testEntity{}, &testUpdate{}, []string{"Name"}},
+		{"not pointers", testEntity{}, testEntity{}, []string{"Name"}},
+		{"unknown field", &testEntity{}, &testEntity{}, []string{"Name", "Unknown"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			err := CopyFields(testCase.dst, testCase.src, testCase.names)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestNullFields(t *testing.T) {
+	names, err := NullFields([]byte(`{"name":"entity","labels":null,"description":null,"unknown":null}`), &testUpdate{})
+	require.NoError(t, err)
+	assert.Equal(t, []string{"description", "labels"}, names)
+
+	names, err = NullFields([]byte(`{}`), testUpdate{})
+	require.NoError(t, err)
+	assert.Empty(t, names)
+
+	_, err = NullFields([]byte(`[]`), testUpdate{})
+	require.Error(t, err)
+	_, err = NullFields([]byte(`{}`), "update")
+	require.Error(t, err)
+}
@@ -0,0 +1,229 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package profile resolves the device profiles extending other device profiles into regular device profiles.
+package profile
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+// Resolver flattens the chains of the DeviceProfileExtensions into regular DeviceProfiles. A base profile is either
+// a regular DeviceProfile or another DeviceProfileExtension. A Resolver is not safe for concurrent use.
+type Resolver struct {
+	profiles   map[string]dtos.DeviceProfile
+	extensions map[string]dtos.DeviceProfileExtension
+}
+
+// NewResolver creates a Resolver without any profile
+func NewResolver() *Resolver {
+	return &Resolver{
+		profiles:   make(map[string]dtos.DeviceProfile),
+		extensions: make(map[string]dtos.DeviceProfileExtension),
+	}
+}
+
+// AddProfile adds the regular DeviceProfile which can be extended
+func (r *Resolver) AddProfile(profile dtos.DeviceProfile) errors.EdgeX {
+	if err := r.checkDuplicate(profile.Name); err != nil {
+		return err
+	}
+	r.profiles[profile.Name] = profile
+	return nil
+}
+
+// AddExtension adds the DeviceProfileExtension to be resolved
+func (r *Resolver) AddExtension(extension dtos.DeviceProfileExtension) errors.EdgeX {
+	if err := extension.Validate(); err != nil {
+		return errors.NewCommonEdgeXWrapper(err)
+	}
+	if err := r.checkDuplicate(extension.Name); err != nil {
+		return err
+	}
+	r.extensions[extension.Name] = extension
+	return nil
+}
+
+// AddYAML adds the device profile file content, which is a DeviceProfileExtension if it has the extends key, or a
+// regular DeviceProfile otherwise
+func (r *Resolver) AddYAML(data []byte) errors.EdgeX {
+	var keys map[string]interface{}
+	if err := yaml.Unmarshal(data, &keys); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal the device profile YAML", err)
+	}
+	if _, ok := keys["extends"]; ok {
+		var extension dtos.DeviceProfileExtension
+		if err := yaml.Unmarshal(data, &extension); err != nil {
+			return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal the device profile extension YAML", err)
+		}
+		return r.AddExtension(extension)
+	}
+	var profile dtos.DeviceProfile
+	if err := yaml.Unmarshal(data, &profile); err != nil {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to unmarshal the device profile YAML", err)
+	}
+	return r.AddProfile(profile)
+}
+
+// Resolve returns the DeviceProfile of the name, where a DeviceProfileExtension is flattened with its base profiles
+// and the result is validated. A KindEntityDoesNotExist error is returned if the profile or one of its base profiles
+// doesn't exist, and a KindContractInvalid error if the chain of the base profiles has a cycle.
+func (r *Resolver) Resolve(name string) (dtos.DeviceProfile, errors.EdgeX) {
+	profile, err := r.resolve(name, nil)
+	if err != nil {
+		return dtos.DeviceProfile{}, err
+	}
+	if _, ok := r.extensions[name]; !ok {
+		return profile, nil
+	}
+	for i, resource := range profile.DeviceResources {
+		valueType, err := v2.NormalizeValueType(resource.Properties.ValueType)
+		if err != nil {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device profile %s", name), err)
+		}
+		profile.DeviceResources[i].Properties.ValueType = valueType
+	}
+	if err := profile.Validate(); err != nil {
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid device profile %s", name), err)
+	}
+	return profile, nil
+}
+
+// ResolveAll resolves all the added profiles, sorted by name
+func (r *Resolver) ResolveAll() ([]dtos.DeviceProfile, errors.EdgeX) {
+	names := make([]string, 0, len(r.profiles)+len(r.extensions))
+	for name := range r.profiles {
+		names = append(names, name)
+	}
+	for name := range r.extensions {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	profiles := make([]dtos.DeviceProfile, len(names))
+	for i, name := range names {
+		profile, err := r.Resolve(name)
+		if err != nil {
+			return nil, err
+		}
+		profiles[i] = profile
+	}
+	return profiles, nil
+}
+
+// resolve flattens the profile, where chain is the names of the extensions being resolved to detect the cycles
+func (r *Resolver) resolve(name string, chain []string) (dtos.DeviceProfile, errors.EdgeX) {
+	for _, n := range chain {
+		if n == name {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("device profile extensions have a cycle: %s", strings.Join(append(chain, name), " -> ")), nil)
+		}
+	}
+	if profile, ok := r.profiles[name]; ok {
+		return profile, nil
+	}
+	extension, ok := r.extensions[name]
+	if !ok {
+		if len(chain) == 0 {
+			return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("device profile %s does not exist", name), nil)
+		}
+		return dtos.DeviceProfile{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist,
+			fmt.Sprintf("base device profile %s of %s does not exist", name, chain[len(chain)-1]), nil)
+	}
+	base, err := r.resolve(extension.Extends, append(chain, name))
+	if err != nil {
+		return dtos.DeviceProfile{}, err
+	}
+	return extend(base, extension), nil
+}
+
+// extend applies the extension to a copy of the base profile
+func extend(base dtos.DeviceProfile, extension dtos.DeviceProfileExtension) dtos.DeviceProfile {
+	profile := dtos.DeviceProfile{
+		Name:         extension.Name,
+		Manufacturer: base.Manufacturer,
+		Description:  base.Description,
+		Model:        base.Model,
+		Labels:       base.Labels,
+	}
+	if extension.Manufacturer != "" {
+		profile.Manufacturer = extension.Manufacturer
+	}
+	if extension.Description != "" {
+		profile.Description = extension.Description
+	}
+	if extension.Model != "" {
+		profile.Model = extension.Model
+	}
+	if len(extension.Labels) > 0 {
+		profile.Labels = extension.Labels
+	}
+
+	removed := toSet(extension.RemovedDeviceResources)
+	overrides := make(map[string]dtos.DeviceResource, len(extension.DeviceResources))
+	for _, resource := range extension.DeviceResources {
+		overrides[resource.Name] = resource
+	}
+	for _, resource := range base.DeviceResources {
+		if override, ok := overrides[resource.Name]; ok {
+			resource = override
+			delete(overrides, resource.Name)
+		}
+		if !removed[resource.Name] {
+			profile.DeviceResources = append(profile.DeviceResources, resource)
+		}
+	}
+	for _, resource := range extension.DeviceResources {
+		if _, added := overrides[resource.Name]; added && !removed[resource.Name] {
+			profile.DeviceResources = append(profile.DeviceResources, resource)
+		}
+	}
+
+	removed = toSet(extension.RemovedDeviceCommands)
+	commandOverrides := make(map[string]dtos.DeviceCommand, len(extension.DeviceCommands))
+	for _, command := range extension.DeviceCommands {
+		commandOverrides[command.Name] = command
+	}
+	for _, command := range base.DeviceCommands {
+		if override, ok := commandOverrides[command.Name]; ok {
+			command = override
+			delete(commandOverrides, command.Name)
+		}
+		if !removed[command.Name] {
+			profile.DeviceCommands = append(profile.DeviceCommands, command)
+		}
+	}
+	for _, command := range extension.DeviceCommands {
+		if _, added := commandOverrides[command.Name]; added && !removed[command.Name] {
+			profile.DeviceCommands = append(profile.DeviceCommands, command)
+		}
+	}
+	return profile
+}
+
+func (r *Resolver) checkDuplicate(name string) errors.EdgeX {
+	_, isProfile := r.profiles[name]
+	_, isExtension := r.extensions[name]
+	if isProfile || isExtension {
+		return errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("device profile %s already exists", name), nil)
+	}
+	return nil
+}
+
+func toSet(names []string) map[string]bool {
+	set := make(map[string]bool, len(names))
+	for _, name := range names {
+		set[name] = true
+	}
+	return set
+}
@@ -0,0 +1,189 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package profile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+func meterProfile() dtos.DeviceProfile {
+	return dtos.DeviceProfile{
+		Name:         "meter",
+		Manufacturer: "IOTech",
+		Model:        "M100",
+		Labels:       []string{"modbus", "meter"},
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "voltage", Attributes: map[string]interface{}{"startingAddress": 1}, Properties: dtos.ResourceProperties{ValueType: v2.ValueTypeFloat32, ReadWrite: v2.ReadWrite_R}},
+			{Name: "current", Attributes: map[string]interface{}{"startingAddress": 3}, Properties: dtos.ResourceProperties{ValueType: v2.ValueTypeFloat32, ReadWrite: v2.ReadWrite_R}},
+			{Name: "reset", Attributes: map[string]interface{}{"startingAddress": 5}, Properties: dtos.ResourceProperties{ValueType: v2.ValueTypeBool, ReadWrite: v2.ReadWrite_W}},
+		},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "readings", ReadWrite: v2.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "voltage"}, {DeviceResource: "current"}}},
+		},
+	}
+}
+
+func TestResolver_Resolve(t *testing.T) {
+	r := NewResolver()
+	require.NoError(t, r.AddProfile(meterProfile()))
+	require.NoError(t, r.AddExtension(dtos.DeviceProfileExtension{
+		Name:    "meter-m200",
+		Extends: "meter",
+		Model:   "M200",
+		DeviceResources: []dtos.DeviceResource{
+			{Name: "current", Attributes: map[string]interface{}{"startingAddress": 7}, Properties: dtos.ResourceProperties{ValueType: "float64", ReadWrite: v2.ReadWrite_R}},
+			{Name: "power", Attributes: map[string]interface{}{"startingAddress": 9}, Properties: dtos.ResourceProperties{ValueType: v2.ValueTypeFloat32, ReadWrite: v2.ReadWrite_R}},
+		},
+		RemovedDeviceResources: []string{"reset"},
+	}))
+	require.NoError(t, r.AddExtension(dtos.DeviceProfileExtension{
+		Name:    "meter-m200-power",
+		Extends: "meter-m200",
+		Labels:  []string{"power"},
+		DeviceCommands: []dtos.DeviceCommand{
+			{Name: "readings", ReadWrite: v2.ReadWrite_R, ResourceOperations: []dtos.ResourceOperation{{DeviceResource: "power"}}},
+		},
+	}))
+
+	base, err := r.Resolve("meter")
+	require.NoError(t, err)
+	assert.Equal(t, meterProfile(), base)
+
+	m200, err := r.Resolve("meter-m200")
+	require.NoError(t, err)
+	assert.Equal(t, "meter-m200", m200.Name)
+	assert.Equal(t, "IOTech", m200.Manufacturer)
+	assert.Equal(t, "M200", m200.Model)
+	assert.Equal(t, []string{"modbus", "meter"}, m200.Labels)
+	require.Len(t, m200.DeviceResources, 3)
+	assert.Equal(t, "voltage", m200.DeviceResources[0].Name)
+	assert.Equal(t, "current", m200.DeviceResources[1].Name)
+	assert.Equal(t, 7, m200.DeviceResources[1].Attributes["startingAddress"])
+	assert.Equal(t, v2.ValueTypeFloat64, m200.DeviceResources[1].Properties.ValueType, "the value type should be normalized")
+	assert.Equal(t, "power", m200.DeviceResources[2].Name)
+	assert.Equal(t, meterProfile().DeviceCommands, m200.DeviceCommands)
+
+	power, err := r.Resolve("meter-m200-power")
+	require.NoError(t, err)
+	assert.Equal(t, "M200", power.Model)
+	assert.Equal(t, []string{"power"}, power.Labels)
+	assert.Len(t, power.DeviceResources, 3)
+	require.Len(t, power.DeviceCommands, 1)
+	assert.Equal(t, []dtos.ResourceOperation{{DeviceResource: "power"}}, power.DeviceCommands[0].ResourceOperations)
+
+	all, err := r.ResolveAll()
+	require.NoError(t, err)
+	require.Len(t, all, 3)
+	assert.Equal(t, "meter", all[0].Name)
+	assert.Equal(t, "meter-m200", all[1].Name)
+	assert.Equal(t, "meter-m200-power", all[2].Name)
+}
+
+func TestResolver_ResolveErrors(t *testing.T) {
+	tests := []struct {
+		name          string
+		extensions    []dtos.DeviceProfileExtension
+		resolve       string
+		expectedKind  errors.ErrKind
+		expectedError string
+	}{
+		{"not found", nil, "unknown", errors.KindEntityDoesNotExist, "device profile unknown does not exist"},
+		{"base not found",
+			[]dtos.DeviceProfileExtension{{Name: "a", Extends: "unknown"}},
+			"a", errors.KindEntityDoesNotExist, "base device profile unknown of a does not exist"},
+		{"cycle",
+			[]dtos.DeviceProfileExtension{{Name: "a", Extends: "b"}, {Name: "b", Extends: "c"}, {Name: "c", Extends: "a"}},
+			"a", errors.KindContractInvalid, "a -> b -> c -> a"},
+		{"self cycle",
+			[]dtos.DeviceProfileExtension{{Name: "a", Extends: "a"}},
+			"a", errors.KindContractInvalid, "a -> a"},
+		{"removed resource still referenced",
+			[]dtos.DeviceProfileExtension{{Name: "a", Extends: "meter", RemovedDeviceResources: []string{"voltage"}}},
+			"a", errors.KindContractInvalid, "invalid device profile a"},
+		{"all resources removed",
+			[]dtos.DeviceProfileExtension{{Name: "a", Extends: "meter", RemovedDeviceResources: []string{"voltage", "current", "reset"}, RemovedDeviceCommands: []string{"readings"}}},
+			"a", errors.KindContractInvalid, "invalid device profile a"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			r := NewResolver()
+			require.NoError(t, r.AddProfile(meterProfile()))
+			for _, e := range testCase.extensions {
+				require.NoError(t, r.AddExtension(e))
+			}
+			_, err := r.Resolve(testCase.resolve)
+			require.Error(t, err)
+			assert.Equal(t, testCase.expectedKind, errors.Kind(err))
+			assert.Contains(t, err.Error(), testCase.expectedError)
+		})
+	}
+}
+
+func TestResolver_Add(t *testing.T) {
+	r := NewResolver()
+	require.NoError(t, r.AddProfile(meterProfile()))
+
+	err := r.AddProfile(meterProfile())
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDuplicateName, errors.Kind(err))
+
+	err = r.AddExtension(dtos.DeviceProfileExtension{Name: "meter", Extends: "other"})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindDuplicateName, errors.Kind(err))
+
+	err = r.AddExtension(dtos.DeviceProfileExtension{Name: "a"})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+
+	err = r.AddExtension(dtos.DeviceProfileExtension{Name: "a", Extends: "meter", DeviceResources: []dtos.DeviceResource{
+		{Name: "voltage", Properties: dtos.ResourceProperties{ValueType: "double", ReadWrite: v2.ReadWrite_R}},
+	}})
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
+
+func TestResolver_AddYAML(t *testing.T) {
+	base := []byte(`
+name: "meter"
+manufacturer: "IOTech"
+deviceResources:
+  - name: "voltage"
+    properties:
+      valueType: "float32"
+      readWrite: "R"
+`)
+	extension := []byte(`
+name: "meter-m200"
+extends: "meter"
+model: "M200"
+deviceResources:
+  - name: "current"
+    properties:
+      valueType: "float32"
+      readWrite: "R"
+`)
+	r := NewResolver()
+	require.NoError(t, r.AddYAML(extension))
+	require.NoError(t, r.AddYAML(base))
+
+	profile, err := r.Resolve("meter-m200")
+	require.NoError(t, err)
+	assert.Equal(t, "IOTech", profile.Manufacturer)
+	assert.Equal(t, "M200", profile.Model)
+	require.Len(t, profile.DeviceResources, 2)
+	assert.Equal(t, v2.ValueTypeFloat32, profile.DeviceResources[1].Properties.ValueType)
+
+	err = r.AddYAML([]byte("name: [\n"))
+	require.Error(t, err)
+	assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+}
@@ -0,0 +1,136 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package provision implements the matching of the discovered devices against the provision watchers, so that the
+// device services and the other tools share the same matching semantics.
+package provision
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"sync"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+// Matcher matches the protocol properties of the discovered devices against the provision watchers. The regular
+// expressions of the watcher Identifiers are compiled once and cached, so a Matcher should be reused across
+// discoveries. A Matcher is safe for concurrent use.
+type Matcher struct {
+	mutex   sync.RWMutex
+	regexps map[string]*regexp.Regexp
+}
+
+// NewMatcher creates a Matcher with an empty regular expression cache
+func NewMatcher() *Matcher {
+	return &Matcher{regexps: make(map[string]*regexp.Regexp)}
+}
+
+// Match checks whether the protocol properties match the provision watcher, i.e. the watcher is not LOCKED, every
+// Identifiers regular expression matches the property with the same key, and no property equals one of the
+// BlockingIdentifiers values of its key. The properties of all protocols are matched as a single set.
+func (m *Matcher) Match(watcher dtos.ProvisionWatcher, protocols map[string]dtos.ProtocolProperties) (bool, errors.EdgeX) {
+	if watcher.AdminState == models.Locked {
+		return false, nil
+	}
+	properties := flatten(protocols)
+	for key, pattern := range watcher.Identifiers {
+		re, err := m.compile(pattern)
+		if err != nil {
+			return false, errors.NewCommonEdgeX(errors.KindContractInvalid,
+				fmt.Sprintf("invalid regular expression of the identifier %s in the provision watcher %s", key, watcher.Name), err)
+		}
+		value, ok := properties[key]
+		if !ok || !re.MatchString(value) {
+			return false, nil
+		}
+	}
+	for key, blocked := range watcher.BlockingIdentifiers {
+		value, ok := properties[key]
+		if !ok {
+			continue
+		}
+		for _, b := range blocked {
+			if value == b {
+				return false, nil
+			}
+		}
+	}
+	return len(watcher.Identifiers) > 0, nil
+}
+
+// MatchWatcher returns the first provision watcher, in the given order, which matches the protocol properties
+func (m *Matcher) MatchWatcher(watchers []dtos.ProvisionWatcher, protocols map[string]dtos.ProtocolProperties) (dtos.ProvisionWatcher, bool, errors.EdgeX) {
+	for _, watcher := range watchers {
+		matched, err := m.Match(watcher, protocols)
+		if err != nil {
+			return dtos.ProvisionWatcher{}, false, errors.NewCommonEdgeXWrapper(err)
+		}
+		if matched {
+			return watcher, true, nil
+		}
+	}
+	return dtos.ProvisionWatcher{}, false, nil
+}
+
+// MatchDevice matches the discovered device against the provision watchers, and returns the device to be added,
+// which takes the ProfileName, ServiceName and AutoEvents from the first matching watcher. The returned bool is false
+// if no watcher matches.
+func (m *Matcher) MatchDevice(discovered dtos.DiscoveredDevice, watchers []dtos.ProvisionWatcher) (dtos.Device, bool, errors.EdgeX) {
+	watcher, matched, err := m.MatchWatcher(watchers, discovered.Protocols)
+	if err != nil || !matched {
+		return dtos.Device{}, false, err
+	}
+	return dtos.Device{
+		Name:           discovered.Name,
+		Description:    discovered.Description,
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         discovered.Labels,
+		ServiceName:    watcher.ServiceName,
+		ProfileName:    watcher.ProfileName,
+		AutoEvents:     watcher.AutoEvents,
+		Protocols:      discovered.Protocols,
+	}, true, nil
+}
+
+func (m *Matcher) compile(pattern string) (*regexp.Regexp, error) {
+	m.mutex.RLock()
+	re, ok := m.regexps[pattern]
+	m.mutex.RUnlock()
+	if ok {
+		return re, nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, err
+	}
+	m.mutex.Lock()
+	m.regexps[pattern] = re
+	m.mutex.Unlock()
+	return re, nil
+}
+
+// flatten merges the properties of all protocols, where the protocols are merged in the order of their names so the
+// result is deterministic if several protocols have the same property key
+func flatten(protocols map[string]dtos.ProtocolProperties) map[string]string {
+	names := make([]string, 0, len(protocols))
+	for name := range protocols {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	properties := make(map[string]string)
+	for _, name := range names {
+		for k, v := range protocols[name] {
+			properties[k] = v
+		}
+	}
+	return properties
+}
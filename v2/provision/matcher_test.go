@@ -0,0 +1,126 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package provision
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+func testWatcher(name string, identifiers map[string]string) dtos.ProvisionWatcher {
+	return dtos.ProvisionWatcher{
+		Name:        name,
+		Identifiers: identifiers,
+		ProfileName: name + "-profile",
+		ServiceName: "test-service",
+		AdminState:  models.Unlocked,
+		AutoEvents:  []dtos.AutoEvent{{Interval: "10s", SourceName: "temperature"}},
+	}
+}
+
+func testProtocols(address string, port string) map[string]dtos.ProtocolProperties {
+	return map[string]dtos.ProtocolProperties{
+		"modbus-tcp": {"Address": address, "Port": port},
+		"other":      {"Vendor": "ACME"},
+	}
+}
+
+func TestMatcher_Match(t *testing.T) {
+	watcher := testWatcher("watcher", map[string]string{"Address": `^192\.168\.0\.[0-9]+$`, "Vendor": "ACME"})
+	blocking := watcher
+	blocking.BlockingIdentifiers = map[string][]string{"Port": {"502", "503"}}
+	locked := watcher
+	locked.AdminState = models.Locked
+	noIdentifiers := watcher
+	noIdentifiers.Identifiers = nil
+	invalidRegex := testWatcher("invalid", map[string]string{"Address": "("})
+
+	tests := []struct {
+		name         string
+		watcher      dtos.ProvisionWatcher
+		protocols    map[string]dtos.ProtocolProperties
+		expected     bool
+		expectedKind errors.ErrKind
+	}{
+		{"match", watcher, testProtocols("192.168.0.10", "502"), true, ""},
+		{"match across protocols", watcher, testProtocols("192.168.0.11", "1502"), true, ""},
+		{"identifier not matched", watcher, testProtocols("10.0.0.1", "502"), false, ""},
+		{"identifier property missing", watcher, map[string]dtos.ProtocolProperties{"other": {"Vendor": "ACME"}}, false, ""},
+		{"blocked", blocking, testProtocols("192.168.0.10", "502"), false, ""},
+		{"not blocked", blocking, testProtocols("192.168.0.10", "1502"), true, ""},
+		{"locked watcher", locked, testProtocols("192.168.0.10", "502"), false, ""},
+		{"watcher without identifiers", noIdentifiers, testProtocols("192.168.0.10", "502"), false, ""},
+		{"invalid regular expression", invalidRegex, testProtocols("192.168.0.10", "502"), false, errors.KindContractInvalid},
+	}
+	m := NewMatcher()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			matched, err := m.Match(tt.watcher, tt.protocols)
+			if tt.expectedKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedKind, errors.Kind(err))
+				assert.Contains(t, err.Error(), "Address")
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, matched)
+		})
+	}
+}
+
+func TestMatcher_MatchDevice(t *testing.T) {
+	lockedFirst := testWatcher("locked", map[string]string{"Address": "192.168"})
+	lockedFirst.AdminState = models.Locked
+	subnet0 := testWatcher("subnet0", map[string]string{"Address": `192\.168\.0\.`})
+	anyAddress := testWatcher("any", map[string]string{"Address": ".*"})
+	watchers := []dtos.ProvisionWatcher{lockedFirst, subnet0, anyAddress}
+
+	discovered := dtos.DiscoveredDevice{Name: "device1", Description: "discovered", Labels: []string{"discovered"}, Protocols: testProtocols("192.168.0.10", "502")}
+	m := NewMatcher()
+	device, matched, err := m.MatchDevice(discovered, watchers)
+	require.NoError(t, err)
+	require.True(t, matched)
+	assert.Equal(t, dtos.Device{
+		Name:           "device1",
+		Description:    "discovered",
+		AdminState:     models.Unlocked,
+		OperatingState: models.Up,
+		Labels:         []string{"discovered"},
+		ServiceName:    subnet0.ServiceName,
+		ProfileName:    subnet0.ProfileName,
+		AutoEvents:     subnet0.AutoEvents,
+		Protocols:      discovered.Protocols,
+	}, device)
+
+	discovered.Protocols = testProtocols("10.0.0.1", "502")
+	device, matched, err = m.MatchDevice(discovered, watchers)
+	require.NoError(t, err)
+	require.True(t, matched)
+	assert.Equal(t, anyAddress.ProfileName, device.ProfileName)
+
+	_, matched, err = m.MatchDevice(discovered, []dtos.ProvisionWatcher{subnet0})
+	require.NoError(t, err)
+	assert.False(t, matched)
+
+	_, _, err = m.MatchDevice(discovered, []dtos.ProvisionWatcher{testWatcher("invalid", map[string]string{"Address": "["})})
+	require.Error(t, err)
+}
+
+func TestMatcher_RegexCache(t *testing.T) {
+	m := NewMatcher()
+	watcher := testWatcher("watcher", map[string]string{"Address": "192", "Port": "502"})
+	_, err := m.Match(watcher, testProtocols("192.168.0.10", "502"))
+	require.NoError(t, err)
+	_, err = m.Match(watcher, testProtocols("192.168.0.11", "502"))
+	require.NoError(t, err)
+	assert.Len(t, m.regexps, 2)
+}
@@ -0,0 +1,128 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package reconcile applies the desired metadata entities to the EdgeX services declaratively. The Reconciler compares
+// the desired entities with the live ones queried by the v2 clients, plans the entities to create, update and delete,
+// and applies the Plan in the dependency order, where the device services, device profiles and intervals are created
+// before the devices, provision watchers and interval actions referring to them, and deleted after them.
+package reconcile
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+)
+
+// Constants for the Action of a Change
+const (
+	ActionCreate Action = "Create"
+	ActionUpdate Action = "Update"
+	ActionDelete Action = "Delete"
+	ActionNone   Action = "None"
+)
+
+// Constants for the entity types
+const (
+	EntityDeviceService    = "DeviceService"
+	EntityDeviceProfile    = "DeviceProfile"
+	EntityDevice           = "Device"
+	EntityProvisionWatcher = "ProvisionWatcher"
+	EntityInterval         = "Interval"
+	EntityIntervalAction   = "IntervalAction"
+	EntitySubscription     = "Subscription"
+)
+
+// entityOrder is the dependency order of the entity types, where an entity only refers to the entities of the former
+// types. The entities are created and updated in this order, and deleted in the reverse order.
+var entityOrder = []string{
+	EntityDeviceService,
+	EntityDeviceProfile,
+	EntityInterval,
+	EntityDevice,
+	EntityProvisionWatcher,
+	EntityIntervalAction,
+	EntitySubscription,
+}
+
+// Action tells how a Change applies to an entity
+type Action string
+
+// State is a set of the desired metadata entities, which are identified by their names
+type State struct {
+	DeviceServices    []dtos.DeviceService    `json:"deviceServices,omitempty"`
+	DeviceProfiles    []dtos.DeviceProfile    `json:"deviceProfiles,omitempty"`
+	Devices           []dtos.Device           `json:"devices,omitempty"`
+	ProvisionWatchers []dtos.ProvisionWatcher `json:"provisionWatchers,omitempty"`
+	Intervals         []dtos.Interval         `json:"intervals,omitempty"`
+	IntervalActions   []dtos.IntervalAction   `json:"intervalActions,omitempty"`
+	Subscriptions     []dtos.Subscription     `json:"subscriptions,omitempty"`
+}
+
+// Change is the planned Action on an entity
+type Change struct {
+	Action     Action `json:"action"`
+	EntityType string `json:"entityType"`
+	Name       string `json:"name"`
+	// Fields lists the updated fields of an Update, i.e. the JSON names of the Update DTO fields, or the paths of the
+	// changed elements of a device profile
+	Fields []string `json:"fields,omitempty"`
+	// request is the Add or Update request of a Create or Update
+	request interface{}
+	// planned is true if the Change is planned by the Reconciler, as the request of a decoded Change is lost
+	planned bool
+}
+
+// String returns the readable description of the Change
+func (c Change) String() string {
+	switch c.Action {
+	case ActionCreate:
+		return fmt.Sprintf("create %s %s", c.EntityType, c.Name)
+	case ActionUpdate:
+		return fmt.Sprintf("update %s %s (%s)", c.EntityType, c.Name, strings.Join(c.Fields, ", "))
+	case ActionDelete:
+		return fmt.Sprintf("delete %s %s", c.EntityType, c.Name)
+	default:
+		return fmt.Sprintf("unchanged %s %s", c.EntityType, c.Name)
+	}
+}
+
+// Plan lists the Changes in the order of applying them, including the unchanged entities with ActionNone. A Plan can
+// be encoded, e.g. to be reviewed, but only the Plan returned by the Reconciler can be executed, as the requests of
+// the Changes are not encoded.
+type Plan struct {
+	Changes []Change `json:"changes"`
+}
+
+// Count returns the number of the Changes of the Action
+func (p Plan) Count(action Action) int {
+	count := 0
+	for _, c := range p.Changes {
+		if c.Action == action {
+			count++
+		}
+	}
+	return count
+}
+
+// HasChanges returns true if any entity is to be created, updated or deleted
+func (p Plan) HasChanges() bool {
+	return p.Count(ActionNone) < len(p.Changes)
+}
+
+// String returns the readable Plan, which lists the Changes except the unchanged entities followed by a summary,
+// e.g. as the output of a dry run
+func (p Plan) String() string {
+	var b strings.Builder
+	for _, c := range p.Changes {
+		if c.Action != ActionNone {
+			b.WriteString(c.String())
+			b.WriteString("\n")
+		}
+	}
+	b.WriteString(fmt.Sprintf("%d to create, %d to update, %d to delete, %d unchanged",
+		p.Count(ActionCreate), p.Count(ActionUpdate), p.Count(ActionDelete), p.Count(ActionNone)))
+	return b.String()
+}
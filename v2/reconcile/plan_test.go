@@ -0,0 +1,34 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlan_String(t *testing.T) {
+	plan := Plan{Changes: []Change{
+		{Action: ActionCreate, EntityType: EntityDeviceService, Name: "service1"},
+		{Action: ActionUpdate, EntityType: EntityDevice, Name: "device1", Fields: []string{"description", "labels"}},
+		{Action: ActionNone, EntityType: EntityDevice, Name: "device2"},
+		{Action: ActionDelete, EntityType: EntityDevice, Name: "device3"},
+	}}
+
+	expected := "create DeviceService service1\n" +
+		"update Device device1 (description, labels)\n" +
+		"delete Device device3\n" +
+		"1 to create, 1 to update, 1 to delete, 1 unchanged"
+	assert.Equal(t, expected, plan.String())
+	assert.True(t, plan.HasChanges())
+}
+
+func TestPlan_HasChanges(t *testing.T) {
+	assert.False(t, Plan{}.HasChanges())
+	assert.False(t, Plan{Changes: []Change{{Action: ActionNone, EntityType: EntityDevice, Name: "device1"}}}.HasChanges())
+	assert.Equal(t, "0 to create, 0 to update, 0 to delete, 0 unchanged", Plan{}.String())
+}
@@ -0,0 +1,803 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/interfaces"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/integrity"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/patch"
+)
+
+// Clients are the v2 clients of the entity types, where a nil client leaves its entity type unmanaged, i.e. its
+// entities are neither queried nor changed
+type Clients struct {
+	DeviceServices    interfaces.DeviceServiceClient
+	DeviceProfiles    interfaces.DeviceProfileClient
+	Devices           interfaces.DeviceClient
+	ProvisionWatchers interfaces.ProvisionWatcherClient
+	Intervals         interfaces.IntervalClient
+	IntervalActions   interfaces.IntervalActionClient
+	Subscriptions     interfaces.SubscriptionClient
+}
+
+// Options controls how the desired State is applied
+type Options struct {
+	// Prune deletes the live entities which are not desired, of the entity types with clients
+	Prune bool
+	// DryRun only plans the Changes without applying them
+	DryRun bool
+}
+
+// Result is the outcome of applying a Change, where Err is nil if the Change succeeded
+type Result struct {
+	Change Change
+	Err    errors.EdgeX
+}
+
+// Reconciler plans and applies the Changes from the live entities to the desired State through the v2 clients
+type Reconciler struct {
+	clients Clients
+}
+
+// NewReconciler creates an instance of Reconciler
+func NewReconciler(clients Clients) *Reconciler {
+	return &Reconciler{clients: clients}
+}
+
+// Apply plans the Changes from the live entities to the desired State, and applies them unless the DryRun option is
+// set. The Results of the applied Changes are returned along with the error of the first failed batch, after which
+// the remaining Changes are not applied.
+func (r *Reconciler) Apply(ctx context.Context, desired State, options Options) (Plan, []Result, errors.EdgeX) {
+	plan, err := r.Plan(ctx, desired, options)
+	if err != nil {
+		return Plan{}, nil, errors.NewCommonEdgeXWrapper(err)
+	}
+	if options.DryRun {
+		return plan, nil, nil
+	}
+	results, err := r.Execute(ctx, plan)
+	if err != nil {
+		return plan, results, errors.NewCommonEdgeXWrapper(err)
+	}
+	return plan, results, nil
+}
+
+// Plan compares the desired State with the live entities queried by the clients and plans the Changes without
+// applying them. An error is returned if a desired entity is invalid or duplicated, a desired entity type has no
+// client, or the entities after the Changes would refer to missing device services or device profiles.
+func (r *Reconciler) Plan(ctx context.Context, desired State, options Options) (Plan, errors.EdgeX) {
+	live, err := r.liveState(ctx, desired)
+	if err != nil {
+		return Plan{}, errors.NewCommonEdgeXWrapper(err)
+	}
+	if r.clients.DeviceServices != nil && r.clients.DeviceProfiles != nil {
+		if issues := integrity.Check(resultSnapshot(desired, live, options.Prune)); len(issues) > 0 {
+			descriptions := make([]string, len(issues))
+			for i, issue := range issues {
+				descriptions[i] = issue.String()
+			}
+			return Plan{}, errors.NewCommonEdgeX(errors.KindContractInvalid, strings.Join(descriptions, "; "), nil)
+		}
+	}
+
+	all := []entities{
+		deviceServiceEntities(desired.DeviceServices, live.DeviceServices),
+		deviceProfileEntities(desired.DeviceProfiles, live.DeviceProfiles),
+		deviceEntities(desired.Devices, live.Devices),
+		provisionWatcherEntities(desired.ProvisionWatchers, live.ProvisionWatchers),
+		intervalEntities(desired.Intervals, live.Intervals),
+		intervalActionEntities(desired.IntervalActions, live.IntervalActions),
+		subscriptionEntities(desired.Subscriptions, live.Subscriptions),
+	}
+	changes := make(map[string][]Change, len(all))
+	deletes := make(map[string][]Change, len(all))
+	for _, e := range all {
+		c, d, err := e.plan(options.Prune)
+		if err != nil {
+			return Plan{}, errors.NewCommonEdgeXWrapper(err)
+		}
+		changes[e.entityType], deletes[e.entityType] = c, d
+	}
+
+	var plan Plan
+	for _, entityType := range entityOrder {
+		plan.Changes = append(plan.Changes, changes[entityType]...)
+	}
+	for i := len(entityOrder) - 1; i >= 0; i-- {
+		plan.Changes = append(plan.Changes, deletes[entityOrder[i]]...)
+	}
+	return plan, nil
+}
+
+// Execute applies the Changes of the Plan in order, where the consecutive Creates or Updates of an entity type are
+// sent in a batch. The Results of the applied Changes are returned along with the error of the first failed batch,
+// after which the remaining Changes are not applied. Only the Plan returned by Plan can be executed, and nothing is
+// applied if any Change is not planned by a Reconciler, e.g. it is decoded, or its entity type has no client.
+func (r *Reconciler) Execute(ctx context.Context, plan Plan) ([]Result, errors.EdgeX) {
+	for _, c := range plan.Changes {
+		if !c.planned {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("%s is not planned by the Reconciler", c), nil)
+		}
+		if c.Action != ActionNone && !r.hasClient(c.EntityType) {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no client to %s", c), nil)
+		}
+	}
+
+	var results []Result
+	for start := 0; start < len(plan.Changes); {
+		end := start + 1
+		for end < len(plan.Changes) && plan.Changes[end].EntityType == plan.Changes[start].EntityType &&
+			plan.Changes[end].Action == plan.Changes[start].Action {
+			end++
+		}
+		batch := plan.Changes[start:end]
+		start = end
+		if batch[0].Action == ActionNone {
+			continue
+		}
+
+		var firstErr errors.EdgeX
+		failed := 0
+		for i, err := range r.apply(ctx, batch) {
+			results = append(results, Result{Change: batch[i], Err: err})
+			if err != nil {
+				failed++
+				if firstErr == nil {
+					firstErr = err
+				}
+			}
+		}
+		if failed > 0 {
+			return results, errors.NewCommonEdgeX(errors.Kind(firstErr),
+				fmt.Sprintf("failed to %s %d of %d %s entities", strings.ToLower(string(batch[0].Action)), failed, len(batch), batch[0].EntityType), firstErr)
+		}
+	}
+	return results, nil
+}
+
+// liveState queries all the live entities of the entity types with clients
+func (r *Reconciler) liveState(ctx context.Context, desired State) (live State, err errors.EdgeX) {
+	for _, t := range []struct {
+		entityType string
+		desired    int
+	}{
+		{EntityDeviceService, len(desired.DeviceServices)},
+		{EntityDeviceProfile, len(desired.DeviceProfiles)},
+		{EntityDevice, len(desired.Devices)},
+		{EntityProvisionWatcher, len(desired.ProvisionWatchers)},
+		{EntityInterval, len(desired.Intervals)},
+		{EntityIntervalAction, len(desired.IntervalActions)},
+		{EntitySubscription, len(desired.Subscriptions)},
+	} {
+		if t.desired > 0 && !r.hasClient(t.entityType) {
+			return live, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no client for the desired %s entities", t.entityType), nil)
+		}
+	}
+
+	// The limit -1 returns all the entities
+	if r.clients.DeviceServices != nil {
+		res, err := r.clients.DeviceServices.AllDeviceServices(ctx, nil, 0, -1)
+		if err != nil {
+			return live, errors.NewCommonEdgeXWrapper(err)
+		}
+		live.DeviceServices = res.Services
+	}
+	if r.clients.DeviceProfiles != nil {
+		res, err := r.clients.DeviceProfiles.AllDeviceProfiles(ctx, nil, 0, -1)
+		if err != nil {
+			return live, errors.NewCommonEdgeXWrapper(err)
+		}
+		live.DeviceProfiles = res.Profiles
+	}
+	if r.clients.Devices != nil {
+		res, err := r.clients.Devices.AllDevices(ctx, nil, 0, -1)
+		if err != nil {
+			return live, errors.NewCommonEdgeXWrapper(err)
+		}
+		live.Devices = res.Devices
+	}
+	if r.clients.ProvisionWatchers != nil {
+		res, err := r.clients.ProvisionWatchers.AllProvisionWatchers(ctx, nil, 0, -1)
+		if err != nil {
+			return live, errors.NewCommonEdgeXWrapper(err)
+		}
+		live.ProvisionWatchers = res.ProvisionWatchers
+	}
+	if r.clients.Intervals != nil {
+		res, err := r.clients.Intervals.AllIntervals(ctx, 0, -1)
+		if err != nil {
+			return live, errors.NewCommonEdgeXWrapper(err)
+		}
+		live.Intervals = res.Intervals
+	}
+	if r.clients.IntervalActions != nil {
+		res, err := r.clients.IntervalActions.AllIntervalActions(ctx, 0, -1)
+		if err != nil {
+			return live, errors.NewCommonEdgeXWrapper(err)
+		}
+		live.IntervalActions = res.Actions
+	}
+	if r.clients.Subscriptions != nil {
+		res, err := r.clients.Subscriptions.AllSubscriptions(ctx, 0, -1)
+		if err != nil {
+			return live, errors.NewCommonEdgeXWrapper(err)
+		}
+		live.Subscriptions = res.Subscriptions
+	}
+	return live, nil
+}
+
+// hasClient returns true if the entity type has a client
+func (r *Reconciler) hasClient(entityType string) bool {
+	switch entityType {
+	case EntityDeviceService:
+		return r.clients.DeviceServices != nil
+	case EntityDeviceProfile:
+		return r.clients.DeviceProfiles != nil
+	case EntityDevice:
+		return r.clients.Devices != nil
+	case EntityProvisionWatcher:
+		return r.clients.ProvisionWatchers != nil
+	case EntityInterval:
+		return r.clients.Intervals != nil
+	case EntityIntervalAction:
+		return r.clients.IntervalActions != nil
+	case EntitySubscription:
+		return r.clients.Subscriptions != nil
+	default:
+		return false
+	}
+}
+
+// resultSnapshot returns the metadata entities after applying the desired State to the live entities
+func resultSnapshot(desired State, live State, prune bool) integrity.Snapshot {
+	s := integrity.Snapshot{
+		Devices:           desired.Devices,
+		DeviceProfiles:    desired.DeviceProfiles,
+		DeviceServices:    desired.DeviceServices,
+		ProvisionWatchers: desired.ProvisionWatchers,
+	}
+	if prune {
+		return s
+	}
+	names := make(map[string]bool)
+	for _, d := range desired.Devices {
+		names[EntityDevice+"/"+d.Name] = true
+	}
+	for _, p := range desired.DeviceProfiles {
+		names[EntityDeviceProfile+"/"+p.Name] = true
+	}
+	for _, ds := range desired.DeviceServices {
+		names[EntityDeviceService+"/"+ds.Name] = true
+	}
+	for _, pw := range desired.ProvisionWatchers {
+		names[EntityProvisionWatcher+"/"+pw.Name] = true
+	}
+	for _, d := range live.Devices {
+		if !names[EntityDevice+"/"+d.Name] {
+			s.Devices = append(s.Devices, d)
+		}
+	}
+	for _, p := range live.DeviceProfiles {
+		if !names[EntityDeviceProfile+"/"+p.Name] {
+			s.DeviceProfiles = append(s.DeviceProfiles, p)
+		}
+	}
+	for _, ds := range live.DeviceServices {
+		if !names[EntityDeviceService+"/"+ds.Name] {
+			s.DeviceServices = append(s.DeviceServices, ds)
+		}
+	}
+	for _, pw := range live.ProvisionWatchers {
+		if !names[EntityProvisionWatcher+"/"+pw.Name] {
+			s.ProvisionWatchers = append(s.ProvisionWatchers, pw)
+		}
+	}
+	return s
+}
+
+// apply applies a batch of the Changes of the same entity type and Action, and returns the error of each Change
+func (r *Reconciler) apply(ctx context.Context, batch []Change) []errors.EdgeX {
+	switch batch[0].Action {
+	case ActionCreate:
+		res, err := r.create(ctx, batch)
+		baseResponses := make([]common.BaseResponse, len(res))
+		for i, br := range res {
+			baseResponses[i] = br.BaseResponse
+		}
+		return responseErrors(baseResponses, err, len(batch))
+	case ActionUpdate:
+		res, err := r.update(ctx, batch)
+		return responseErrors(res, err, len(batch))
+	default:
+		errs := make([]errors.EdgeX, len(batch))
+		for i, c := range batch {
+			res, err := r.delete(ctx, c)
+			errs[i] = responseErrors([]common.BaseResponse{res}, err, 1)[0]
+		}
+		return errs
+	}
+}
+
+func (r *Reconciler) create(ctx context.Context, batch []Change) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	switch batch[0].EntityType {
+	case EntityDeviceService:
+		reqs := make([]requests.AddDeviceServiceRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.AddDeviceServiceRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.DeviceServices.Add(ctx, reqs)
+	case EntityDeviceProfile:
+		reqs := make([]requests.DeviceProfileRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.DeviceProfileRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.DeviceProfiles.Add(ctx, reqs)
+	case EntityDevice:
+		reqs := make([]requests.AddDeviceRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.AddDeviceRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.Devices.Add(ctx, reqs)
+	case EntityProvisionWatcher:
+		reqs := make([]requests.AddProvisionWatcherRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.AddProvisionWatcherRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.ProvisionWatchers.Add(ctx, reqs)
+	case EntityInterval:
+		reqs := make([]requests.AddIntervalRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.AddIntervalRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.Intervals.Add(ctx, reqs)
+	case EntityIntervalAction:
+		reqs := make([]requests.AddIntervalActionRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.AddIntervalActionRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.IntervalActions.Add(ctx, reqs)
+	case EntitySubscription:
+		reqs := make([]requests.AddSubscriptionRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.AddSubscriptionRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.Subscriptions.Add(ctx, reqs)
+	default:
+		return nil, unknownEntityType(batch[0].EntityType)
+	}
+}
+
+func (r *Reconciler) update(ctx context.Context, batch []Change) ([]common.BaseResponse, errors.EdgeX) {
+	switch batch[0].EntityType {
+	case EntityDeviceService:
+		reqs := make([]requests.UpdateDeviceServiceRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.UpdateDeviceServiceRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.DeviceServices.Update(ctx, reqs)
+	case EntityDeviceProfile:
+		reqs := make([]requests.DeviceProfileRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.DeviceProfileRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.DeviceProfiles.Update(ctx, reqs)
+	case EntityDevice:
+		reqs := make([]requests.UpdateDeviceRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.UpdateDeviceRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.Devices.Update(ctx, reqs)
+	case EntityProvisionWatcher:
+		reqs := make([]requests.UpdateProvisionWatcherRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.UpdateProvisionWatcherRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.ProvisionWatchers.Update(ctx, reqs)
+	case EntityInterval:
+		reqs := make([]requests.UpdateIntervalRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.UpdateIntervalRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.Intervals.Update(ctx, reqs)
+	case EntityIntervalAction:
+		reqs := make([]requests.UpdateIntervalActionRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.UpdateIntervalActionRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.IntervalActions.Update(ctx, reqs)
+	case EntitySubscription:
+		reqs := make([]requests.UpdateSubscriptionRequest, len(batch))
+		for i, c := range batch {
+			req, ok := c.request.(requests.UpdateSubscriptionRequest)
+			if !ok {
+				return nil, unexpectedRequest(c)
+			}
+			reqs[i] = req
+		}
+		return r.clients.Subscriptions.Update(ctx, reqs)
+	default:
+		return nil, unknownEntityType(batch[0].EntityType)
+	}
+}
+
+func (r *Reconciler) delete(ctx context.Context, c Change) (common.BaseResponse, errors.EdgeX) {
+	switch c.EntityType {
+	case EntityDeviceService:
+		return r.clients.DeviceServices.DeleteByName(ctx, c.Name)
+	case EntityDeviceProfile:
+		return r.clients.DeviceProfiles.DeleteByName(ctx, c.Name)
+	case EntityDevice:
+		return r.clients.Devices.DeleteDeviceByName(ctx, c.Name)
+	case EntityProvisionWatcher:
+		return r.clients.ProvisionWatchers.DeleteProvisionWatcherByName(ctx, c.Name)
+	case EntityInterval:
+		return r.clients.Intervals.DeleteIntervalByName(ctx, c.Name)
+	case EntityIntervalAction:
+		return r.clients.IntervalActions.DeleteIntervalActionByName(ctx, c.Name)
+	case EntitySubscription:
+		return r.clients.Subscriptions.DeleteSubscriptionByName(ctx, c.Name)
+	default:
+		return common.BaseResponse{}, unknownEntityType(c.EntityType)
+	}
+}
+
+// responseErrors returns the error of each of the count requests from the responses of a batch, where the error of
+// the whole batch is the error of every request
+func responseErrors(res []common.BaseResponse, err errors.EdgeX, count int) []errors.EdgeX {
+	errs := make([]errors.EdgeX, count)
+	if err == nil && len(res) != count {
+		err = errors.NewCommonEdgeX(errors.KindServerError, fmt.Sprintf("expected %d responses but got %d", count, len(res)), nil)
+	}
+	for i := range errs {
+		switch {
+		case err != nil:
+			errs[i] = err
+		case res[i].StatusCode >= http.StatusMultipleChoices:
+			errs[i] = errors.NewCommonEdgeX(errors.KindMapping(res[i].StatusCode), res[i].Message, nil)
+		}
+	}
+	return errs
+}
+
+// unexpectedRequest returns the error of a Change whose request doesn't match its entity type and Action
+func unexpectedRequest(c Change) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unexpected request %T to %s", c.request, c), nil)
+}
+
+func unknownEntityType(entityType string) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unknown entity type %s", entityType), nil)
+}
+
+// validator is implemented by the Add requests
+type validator interface {
+	Validate() error
+}
+
+// entities adapts the desired and live entities of an entity type to the planning, where the entities are identified
+// by their names
+type entities struct {
+	entityType string
+	desired    []string
+	live       []string
+	// add returns the Add request of the ith desired entity
+	add func(i int) validator
+	// update returns the Update request and the updated fields of the ith desired entity from the live entity of the
+	// same name, or nil if they are the same
+	update func(i int) (interface{}, []string)
+}
+
+// plan returns the Creates, Updates and unchanged entities, each in the desired order so that they are applied in
+// batches, and the Deletes of the live entities which are not desired sorted by name if prune is true
+func (e entities) plan(prune bool) (changes []Change, deletes []Change, err errors.EdgeX) {
+	var creates, updates, unchanged []Change
+	live := make(map[string]bool, len(e.live))
+	for _, name := range e.live {
+		live[name] = true
+	}
+	desired := make(map[string]bool, len(e.desired))
+	for i, name := range e.desired {
+		if desired[name] {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("duplicate %s %s", e.entityType, name), nil)
+		}
+		desired[name] = true
+		req := e.add(i)
+		if err := req.Validate(); err != nil {
+			return nil, nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid %s %s", e.entityType, name), err)
+		}
+		if !live[name] {
+			creates = append(creates, Change{Action: ActionCreate, EntityType: e.entityType, Name: name, request: req, planned: true})
+			continue
+		}
+		update, fields := e.update(i)
+		if update == nil {
+			unchanged = append(unchanged, Change{Action: ActionNone, EntityType: e.entityType, Name: name, planned: true})
+			continue
+		}
+		updates = append(updates, Change{Action: ActionUpdate, EntityType: e.entityType, Name: name, Fields: fields, request: update, planned: true})
+	}
+	changes = append(append(creates, updates...), unchanged...)
+	if !prune {
+		return changes, nil, nil
+	}
+	names := append([]string(nil), e.live...)
+	sort.Strings(names)
+	for _, name := range names {
+		if !desired[name] {
+			deletes = append(deletes, Change{Action: ActionDelete, EntityType: e.entityType, Name: name, planned: true})
+		}
+	}
+	return changes, deletes, nil
+}
+
+// updatedFields returns the JSON names of the fields set by the Update DTO or cleared by the mask except the id and name
+func updatedFields(update interface{}, mask []string) []string {
+	fields, err := patch.FromUpdate(update, mask...)
+	if err != nil {
+		return nil
+	}
+	delete(fields, "id")
+	delete(fields, "name")
+	return fields.Names()
+}
+
+func deviceServiceEntities(desired []dtos.DeviceService, live []dtos.DeviceService) entities {
+	e := entities{entityType: EntityDeviceService}
+	liveByName := make(map[string]dtos.DeviceService, len(live))
+	for _, ds := range live {
+		e.live = append(e.live, ds.Name)
+		liveByName[ds.Name] = ds
+	}
+	for _, ds := range desired {
+		e.desired = append(e.desired, ds.Name)
+	}
+	e.add = func(i int) validator {
+		return requests.NewAddDeviceServiceRequest(desired[i])
+	}
+	e.update = func(i int) (interface{}, []string) {
+		current, newer := liveByName[desired[i].Name], desired[i]
+		// The connection status is reported by the device service itself, so it is kept unless desired
+		if newer.LastConnected == 0 {
+			newer.LastConnected = current.LastConnected
+		}
+		if newer.LastReported == 0 {
+			newer.LastReported = current.LastReported
+		}
+		update, mask := current.UpdateTo(newer)
+		if update == nil {
+			return nil, nil
+		}
+		req := requests.NewUpdateDeviceServiceRequest(*update)
+		req.FieldMask = mask
+		return req, updatedFields(*update, mask)
+	}
+	return e
+}
+
+func deviceProfileEntities(desired []dtos.DeviceProfile, live []dtos.DeviceProfile) entities {
+	e := entities{entityType: EntityDeviceProfile}
+	liveByName := make(map[string]dtos.DeviceProfile, len(live))
+	for _, p := range live {
+		e.live = append(e.live, p.Name)
+		liveByName[p.Name] = p
+	}
+	for _, p := range desired {
+		e.desired = append(e.desired, p.Name)
+	}
+	e.add = func(i int) validator {
+		return requests.NewDeviceProfileRequest(desired[i])
+	}
+	// A device profile is updated as a whole, and the updated fields are the paths of its changed elements
+	e.update = func(i int) (interface{}, []string) {
+		diff := liveByName[desired[i].Name].Diff(desired[i])
+		if diff.IsEmpty() {
+			return nil, nil
+		}
+		fields := make([]string, len(diff.Changes))
+		for j, c := range diff.Changes {
+			fields[j] = c.Path
+		}
+		return requests.NewDeviceProfileRequest(desired[i]), fields
+	}
+	return e
+}
+
+func deviceEntities(desired []dtos.Device, live []dtos.Device) entities {
+	e := entities{entityType: EntityDevice}
+	liveByName := make(map[string]dtos.Device, len(live))
+	for _, d := range live {
+		e.live = append(e.live, d.Name)
+		liveByName[d.Name] = d
+	}
+	for _, d := range desired {
+		e.desired = append(e.desired, d.Name)
+	}
+	e.add = func(i int) validator {
+		return requests.NewAddDeviceRequest(desired[i])
+	}
+	e.update = func(i int) (interface{}, []string) {
+		current, newer := liveByName[desired[i].Name], desired[i]
+		// The operating state is reported by the device service, so the live one is kept
+		newer.OperatingState = current.OperatingState
+		// The connection status is reported by the device service, so it is kept unless desired
+		if newer.LastConnected == 0 {
+			newer.LastConnected = current.LastConnected
+		}
+		if newer.LastReported == 0 {
+			newer.LastReported = current.LastReported
+		}
+		update, mask := current.UpdateTo(newer)
+		if update == nil {
+			return nil, nil
+		}
+		req := requests.NewUpdateDeviceRequest(*update)
+		req.FieldMask = mask
+		return req, updatedFields(*update, mask)
+	}
+	return e
+}
+
+func provisionWatcherEntities(desired []dtos.ProvisionWatcher, live []dtos.ProvisionWatcher) entities {
+	e := entities{entityType: EntityProvisionWatcher}
+	liveByName := make(map[string]dtos.ProvisionWatcher, len(live))
+	for _, pw := range live {
+		e.live = append(e.live, pw.Name)
+		liveByName[pw.Name] = pw
+	}
+	for _, pw := range desired {
+		e.desired = append(e.desired, pw.Name)
+	}
+	e.add = func(i int) validator {
+		return requests.NewAddProvisionWatcherRequest(desired[i])
+	}
+	e.update = func(i int) (interface{}, []string) {
+		update, mask := liveByName[desired[i].Name].UpdateTo(desired[i])
+		if update == nil {
+			return nil, nil
+		}
+		req := requests.NewUpdateProvisionWatcherRequest(*update)
+		req.FieldMask = mask
+		return req, updatedFields(*update, mask)
+	}
+	return e
+}
+
+func intervalEntities(desired []dtos.Interval, live []dtos.Interval) entities {
+	e := entities{entityType: EntityInterval}
+	liveByName := make(map[string]dtos.Interval, len(live))
+	for _, i := range live {
+		e.live = append(e.live, i.Name)
+		liveByName[i.Name] = i
+	}
+	for _, i := range desired {
+		e.desired = append(e.desired, i.Name)
+	}
+	e.add = func(i int) validator {
+		return requests.NewAddIntervalRequest(desired[i])
+	}
+	e.update = func(i int) (interface{}, []string) {
+		update, mask := liveByName[desired[i].Name].UpdateTo(desired[i])
+		if update == nil {
+			return nil, nil
+		}
+		req := requests.NewUpdateIntervalRequest(*update)
+		req.FieldMask = mask
+		return req, updatedFields(*update, mask)
+	}
+	return e
+}
+
+func intervalActionEntities(desired []dtos.IntervalAction, live []dtos.IntervalAction) entities {
+	e := entities{entityType: EntityIntervalAction}
+	liveByName := make(map[string]dtos.IntervalAction, len(live))
+	for _, a := range live {
+		e.live = append(e.live, a.Name)
+		liveByName[a.Name] = a
+	}
+	for _, a := range desired {
+		e.desired = append(e.desired, a.Name)
+	}
+	e.add = func(i int) validator {
+		return requests.NewAddIntervalActionRequest(desired[i])
+	}
+	e.update = func(i int) (interface{}, []string) {
+		update, mask := liveByName[desired[i].Name].UpdateTo(desired[i])
+		if update == nil {
+			return nil, nil
+		}
+		req := requests.NewUpdateIntervalActionRequest(*update)
+		req.FieldMask = mask
+		return req, updatedFields(*update, mask)
+	}
+	return e
+}
+
+func subscriptionEntities(desired []dtos.Subscription, live []dtos.Subscription) entities {
+	e := entities{entityType: EntitySubscription}
+	liveByName := make(map[string]dtos.Subscription, len(live))
+	for _, s := range live {
+		e.live = append(e.live, s.Name)
+		liveByName[s.Name] = s
+	}
+	for _, s := range desired {
+		e.desired = append(e.desired, s.Name)
+	}
+	e.add = func(i int) validator {
+		return requests.NewAddSubscriptionRequest(desired[i])
+	}
+	e.update = func(i int) (interface{}, []string) {
+		update, mask := liveByName[desired[i].Name].UpdateTo(desired[i])
+		if update == nil {
+			return nil, nil
+		}
+		req := requests.NewUpdateSubscriptionRequest(*update)
+		req.FieldMask = mask
+		return req, updatedFields(*update, mask)
+	}
+	return e
+}
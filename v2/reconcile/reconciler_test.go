@@ -0,0 +1,429 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package reconcile
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	clientHttp "github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/http/fake"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/responses"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testServiceName  = "test-service"
+	testProfileName  = "test-profile"
+	testDeviceName   = "test-device"
+	testWatcherName  = "test-watcher"
+	testResourceName = "temperature"
+	testIntervalName = "test-interval"
+)
+
+func testState() State {
+	return State{
+		DeviceServices: []dtos.DeviceService{{
+			Name:        testServiceName,
+			BaseAddress: "http://localhost:59900",
+			AdminState:  models.Unlocked,
+		}},
+		DeviceProfiles: []dtos.DeviceProfile{{
+			Name:         testProfileName,
+			Manufacturer: "test-manufacturer",
+			Model:        "test-model",
+			DeviceResources: []dtos.DeviceResource{{
+				Name:       testResourceName,
+				Properties: dtos.ResourceProperties{ValueType: v2.ValueTypeInt16, ReadWrite: v2.ReadWrite_R},
+			}},
+		}},
+		Devices: []dtos.Device{{
+			Name:           testDeviceName,
+			AdminState:     models.Unlocked,
+			OperatingState: models.Up,
+			ServiceName:    testServiceName,
+			ProfileName:    testProfileName,
+			Labels:         []string{"sensor"},
+			Protocols:      map[string]dtos.ProtocolProperties{"other": {"Address": "localhost"}},
+		}},
+		ProvisionWatchers: []dtos.ProvisionWatcher{{
+			Name:        testWatcherName,
+			Identifiers: map[string]string{"address": "localhost"},
+			ProfileName: testProfileName,
+			ServiceName: testServiceName,
+			AdminState:  models.Unlocked,
+		}},
+	}
+}
+
+func newTestReconciler(server *fake.Server) *Reconciler {
+	return NewReconciler(Clients{
+		DeviceServices:    clientHttp.NewDeviceServiceClient(server.URL),
+		DeviceProfiles:    clientHttp.NewDeviceProfileClient(server.URL),
+		Devices:           clientHttp.NewDeviceClient(server.URL),
+		ProvisionWatchers: clientHttp.NewProvisionWatcherClient(server.URL),
+	})
+}
+
+func changeStrings(plan Plan) []string {
+	var result []string
+	for _, c := range plan.Changes {
+		result = append(result, c.String())
+	}
+	return result
+}
+
+func TestReconciler_Apply(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	reconciler := newTestReconciler(server)
+
+	plan, results, err := reconciler.Apply(ctx, testState(), Options{DryRun: true})
+	require.NoError(t, err)
+	assert.Nil(t, results)
+	assert.Equal(t, []string{
+		"create DeviceService test-service",
+		"create DeviceProfile test-profile",
+		"create Device test-device",
+		"create ProvisionWatcher test-watcher",
+	}, changeStrings(plan))
+	devices, err := clientHttp.NewDeviceClient(server.URL).AllDevices(ctx, nil, 0, -1)
+	require.NoError(t, err)
+	assert.Empty(t, devices.Devices, "a dry run should not apply the plan")
+
+	plan, results, err = reconciler.Apply(ctx, testState(), Options{})
+	require.NoError(t, err)
+	require.Len(t, results, 4)
+	for _, result := range results {
+		assert.NoError(t, result.Err)
+	}
+	_, err = clientHttp.NewDeviceClient(server.URL).DeviceByName(ctx, testDeviceName)
+	require.NoError(t, err)
+
+	plan, err = reconciler.Plan(ctx, testState(), Options{})
+	require.NoError(t, err)
+	assert.False(t, plan.HasChanges())
+	assert.Equal(t, 4, plan.Count(ActionNone))
+}
+
+func TestReconciler_Apply_Update(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	reconciler := newTestReconciler(server)
+	_, _, err := reconciler.Apply(ctx, testState(), Options{})
+	require.NoError(t, err)
+
+	desired := testState()
+	desired.Devices[0].Description = "updated"
+	desired.Devices[0].Labels = nil
+	desired.DeviceProfiles[0].Model = "updated-model"
+	plan, results, err := reconciler.Apply(ctx, desired, Options{})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"unchanged DeviceService test-service",
+		"update DeviceProfile test-profile (model)",
+		"update Device test-device (description, labels)",
+		"unchanged ProvisionWatcher test-watcher",
+	}, changeStrings(plan))
+	assert.Len(t, results, 2)
+	device, err := clientHttp.NewDeviceClient(server.URL).DeviceByName(ctx, testDeviceName)
+	require.NoError(t, err)
+	assert.Equal(t, "updated", device.Device.Description)
+	assert.Empty(t, device.Device.Labels)
+
+	plan, err = reconciler.Plan(ctx, desired, Options{})
+	require.NoError(t, err)
+	assert.False(t, plan.HasChanges())
+}
+
+func TestReconciler_Apply_ClearedFields(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	reconciler := newTestReconciler(server)
+	initial := testState()
+	initial.Devices[0].Description = "described"
+	initial.Devices[0].Location = "room1"
+	_, _, err := reconciler.Apply(ctx, initial, Options{})
+	require.NoError(t, err)
+
+	// The cleared description and location can't be set by the UpdateDevice, so they are cleared by the field mask
+	plan, _, err := reconciler.Apply(ctx, testState(), Options{})
+
+	require.NoError(t, err)
+	assert.Contains(t, changeStrings(plan), "update Device test-device (description, location)")
+	device, err := clientHttp.NewDeviceClient(server.URL).DeviceByName(ctx, testDeviceName)
+	require.NoError(t, err)
+	assert.Empty(t, device.Device.Description)
+	assert.Nil(t, device.Device.Location)
+
+	plan, err = reconciler.Plan(ctx, testState(), Options{})
+	require.NoError(t, err)
+	assert.False(t, plan.HasChanges())
+}
+
+func TestReconciler_Apply_Prune(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	reconciler := newTestReconciler(server)
+	_, _, err := reconciler.Apply(ctx, testState(), Options{})
+	require.NoError(t, err)
+
+	plan, err := reconciler.Plan(ctx, State{}, Options{})
+	require.NoError(t, err)
+	assert.Empty(t, plan.Changes, "the live entities should be kept without pruning")
+
+	plan, results, err := reconciler.Apply(ctx, State{}, Options{Prune: true})
+	require.NoError(t, err)
+	// The devices and provision watchers are deleted before the profiles and services they refer to
+	assert.Equal(t, []string{
+		"delete ProvisionWatcher test-watcher",
+		"delete Device test-device",
+		"delete DeviceProfile test-profile",
+		"delete DeviceService test-service",
+	}, changeStrings(plan))
+	assert.Len(t, results, 4)
+	services, err := clientHttp.NewDeviceServiceClient(server.URL).AllDeviceServices(ctx, nil, 0, -1)
+	require.NoError(t, err)
+	assert.Empty(t, services.Services)
+}
+
+func TestReconciler_Plan_Errors(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+
+	duplicate := testState()
+	duplicate.Devices = append(duplicate.Devices, duplicate.Devices[0])
+	invalid := testState()
+	invalid.Devices[0].AdminState = "ENABLED"
+	missingService := testState()
+	missingService.DeviceServices = nil
+	unmanaged := testState()
+	unmanaged.Intervals = []dtos.Interval{{Name: testIntervalName, Interval: "10m"}}
+
+	tests := []struct {
+		name    string
+		desired State
+	}{
+		{"duplicate name", duplicate},
+		{"invalid entity", invalid},
+		{"missing service", missingService},
+		{"no client", unmanaged},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := newTestReconciler(server).Plan(context.Background(), testCase.desired, Options{})
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestReconciler_Apply_Intervals(t *testing.T) {
+	ctx := context.Background()
+	intervals := &stubIntervalClient{live: []dtos.Interval{
+		{Name: "daily", Interval: "24h"},
+		{Name: "hourly", Interval: "1h"},
+		{Name: "obsolete", Interval: "1m"},
+	}}
+	subscriptions := &stubSubscriptionClient{}
+	reconciler := NewReconciler(Clients{Intervals: intervals, Subscriptions: subscriptions})
+	desired := State{
+		Intervals: []dtos.Interval{
+			{Name: "daily", Interval: "24h"},
+			{Name: "hourly", Interval: "2h"},
+			{Name: "weekly", Interval: "168h"},
+		},
+		Subscriptions: []dtos.Subscription{{
+			Name:       "test-subscription",
+			Channels:   []dtos.Address{dtos.NewEmailAddress([]string{"test@example.com"})},
+			Receiver:   "test-receiver",
+			Categories: []string{"health-check"},
+			AdminState: models.Unlocked,
+		}},
+	}
+
+	plan, _, err := reconciler.Apply(ctx, desired, Options{Prune: true})
+
+	require.NoError(t, err)
+	assert.Equal(t, []string{
+		"create Interval weekly",
+		"update Interval hourly (interval)",
+		"unchanged Interval daily",
+		"create Subscription test-subscription",
+		"delete Interval obsolete",
+	}, changeStrings(plan))
+	require.Len(t, intervals.added, 1)
+	assert.Equal(t, "weekly", intervals.added[0].Interval.Name)
+	require.Len(t, intervals.updated, 1)
+	assert.Equal(t, "2h", *intervals.updated[0].Interval.Interval)
+	assert.Equal(t, []string{"obsolete"}, intervals.deleted)
+	require.Len(t, subscriptions.added, 1)
+}
+
+func TestReconciler_Execute_Failure(t *testing.T) {
+	ctx := context.Background()
+	intervals := &stubIntervalClient{statusCode: http.StatusConflict, live: []dtos.Interval{{Name: "other", Interval: "1h"}}}
+	reconciler := NewReconciler(Clients{Intervals: intervals})
+	desired := State{Intervals: []dtos.Interval{{Name: "daily", Interval: "24h"}}}
+	plan, err := reconciler.Plan(ctx, desired, Options{Prune: true})
+	require.NoError(t, err)
+	require.Equal(t, []string{"create Interval daily", "delete Interval other"}, changeStrings(plan))
+
+	results, err := reconciler.Execute(ctx, plan)
+
+	require.Error(t, err)
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(err))
+	require.Len(t, results, 1, "the changes after the failed batch should not be applied")
+	assert.Equal(t, errors.KindStatusConflict, errors.Kind(results[0].Err))
+	assert.Empty(t, intervals.deleted)
+}
+
+func TestReconciler_Execute_NotPlanned(t *testing.T) {
+	ctx := context.Background()
+	intervals := &stubIntervalClient{statusCode: http.StatusOK}
+	reconciler := NewReconciler(Clients{Intervals: intervals})
+	desired := State{Intervals: []dtos.Interval{{Name: "daily", Interval: "24h"}}}
+	plan, err := reconciler.Plan(ctx, desired, Options{})
+	require.NoError(t, err)
+	data, e := json.Marshal(plan)
+	require.NoError(t, e)
+	var decoded Plan
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	deletion := Plan{Changes: []Change{{Action: ActionDelete, EntityType: EntityInterval, Name: "daily"}}}
+
+	tests := []struct {
+		name       string
+		reconciler *Reconciler
+		plan       Plan
+	}{
+		{"decoded plan", reconciler, decoded},
+		{"built plan", reconciler, deletion},
+		{"no client", NewReconciler(Clients{}), plan},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			results, err := testCase.reconciler.Execute(ctx, testCase.plan)
+
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+			assert.Empty(t, results)
+			assert.Empty(t, intervals.added)
+			assert.Empty(t, intervals.deleted)
+		})
+	}
+}
+
+func TestReconciler_Plan_LiveOperatingState(t *testing.T) {
+	server := fake.NewServer()
+	defer server.Close()
+	ctx := context.Background()
+	reconciler := newTestReconciler(server)
+	_, _, err := reconciler.Apply(ctx, testState(), Options{})
+	require.NoError(t, err)
+	name, down := testDeviceName, models.Down
+	_, err = clientHttp.NewDeviceClient(server.URL).Update(ctx, []requests.UpdateDeviceRequest{
+		requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name, OperatingState: &down}),
+	})
+	require.NoError(t, err)
+
+	// The operating state reported by the device service is kept
+	plan, err := reconciler.Plan(ctx, testState(), Options{})
+
+	require.NoError(t, err)
+	assert.False(t, plan.HasChanges())
+}
+
+// stubIntervalClient serves the live intervals and records the requests, which are answered with the statusCode
+type stubIntervalClient struct {
+	live       []dtos.Interval
+	statusCode int
+	added      []requests.AddIntervalRequest
+	updated    []requests.UpdateIntervalRequest
+	deleted    []string
+}
+
+func (c *stubIntervalClient) status(success int) int {
+	if c.statusCode != 0 {
+		return c.statusCode
+	}
+	return success
+}
+
+func (c *stubIntervalClient) Add(_ context.Context, reqs []requests.AddIntervalRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	c.added = append(c.added, reqs...)
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		res[i] = common.NewBaseWithIdResponse(req.RequestId, "", c.status(http.StatusCreated), "")
+	}
+	return res, nil
+}
+
+func (c *stubIntervalClient) Update(_ context.Context, reqs []requests.UpdateIntervalRequest) ([]common.BaseResponse, errors.EdgeX) {
+	c.updated = append(c.updated, reqs...)
+	res := make([]common.BaseResponse, len(reqs))
+	for i, req := range reqs {
+		res[i] = common.NewBaseResponse(req.RequestId, "", c.status(http.StatusOK))
+	}
+	return res, nil
+}
+
+func (c *stubIntervalClient) AllIntervals(_ context.Context, _ int, _ int) (responses.MultiIntervalsResponse, errors.EdgeX) {
+	return responses.NewMultiIntervalsResponse("", "", http.StatusOK, c.live), nil
+}
+
+func (c *stubIntervalClient) IntervalByName(_ context.Context, name string) (responses.IntervalResponse, errors.EdgeX) {
+	return responses.IntervalResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, name, nil)
+}
+
+func (c *stubIntervalClient) DeleteIntervalByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	c.deleted = append(c.deleted, name)
+	return common.NewBaseResponse("", "", c.status(http.StatusOK)), nil
+}
+
+// stubSubscriptionClient has no live subscriptions and records the added subscriptions
+type stubSubscriptionClient struct {
+	added []requests.AddSubscriptionRequest
+}
+
+func (c *stubSubscriptionClient) Add(_ context.Context, reqs []requests.AddSubscriptionRequest) ([]common.BaseWithIdResponse, errors.EdgeX) {
+	c.added = append(c.added, reqs...)
+	res := make([]common.BaseWithIdResponse, len(reqs))
+	for i, req := range reqs {
+		res[i] = common.NewBaseWithIdResponse(req.RequestId, "", http.StatusCreated, "")
+	}
+	return res, nil
+}
+
+func (c *stubSubscriptionClient) Update(_ context.Context, reqs []requests.UpdateSubscriptionRequest) ([]common.BaseResponse, errors.EdgeX) {
+	return nil, errors.NewCommonEdgeX(errors.KindNotImplemented, "update is not expected", nil)
+}
+
+func (c *stubSubscriptionClient) AllSubscriptions(_ context.Context, _ int, _ int) (responses.MultiSubscriptionsResponse, errors.EdgeX) {
+	return responses.NewMultiSubscriptionsResponse("", "", http.StatusOK, nil), nil
+}
+
+func (c *stubSubscriptionClient) SubscriptionByName(_ context.Context, name string) (responses.SubscriptionResponse, errors.EdgeX) {
+	return responses.SubscriptionResponse{}, errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, name, nil)
+}
+
+func (c *stubSubscriptionClient) DeleteSubscriptionByName(_ context.Context, name string) (common.BaseResponse, errors.EdgeX) {
+	return common.BaseResponse{}, errors.NewCommonEdgeX(errors.KindNotImplemented, "delete is not expected", nil)
+}
@@ -0,0 +1,103 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+)
+
+// RouteBuilder expands a route template like ApiDeviceByNameRoute into a request path by filling the {name}
+// placeholders with the escaped values of the params
+type RouteBuilder struct {
+	template string
+	params   map[string]string
+}
+
+// NewRouteBuilder creates a RouteBuilder of the route template
+func NewRouteBuilder(template string) *RouteBuilder {
+	return &RouteBuilder{template: template, params: make(map[string]string)}
+}
+
+// SetParam sets the value of the {name} placeholder
+func (b *RouteBuilder) SetParam(name string, value string) *RouteBuilder {
+	b.params[name] = value
+	return b
+}
+
+// SetIntParam sets the integer value of the {name} placeholder
+func (b *RouteBuilder) SetIntParam(name string, value int) *RouteBuilder {
+	return b.SetParam(name, strconv.Itoa(value))
+}
+
+// Build returns the request path, see ExpandRoute
+func (b *RouteBuilder) Build() (string, errors.EdgeX) {
+	return ExpandRoute(b.template, b.params)
+}
+
+// ExpandRoute fills the {name} placeholders of the route template with the escaped values of the params. An error is
+// returned if a placeholder has no value or an empty value, or a param doesn't match any placeholder of the template.
+func ExpandRoute(template string, params map[string]string) (string, errors.EdgeX) {
+	names, err := RouteParams(template)
+	if err != nil {
+		return "", errors.NewCommonEdgeXWrapper(err)
+	}
+	placeholders := make(map[string]bool, len(names))
+	for _, name := range names {
+		placeholders[name] = true
+		if params[name] == "" {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("no value of the path parameter %s of the route %s", name, template), nil)
+		}
+	}
+	for name := range params {
+		if !placeholders[name] {
+			return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the route %s has no path parameter %s", template, name), nil)
+		}
+	}
+
+	var builder strings.Builder
+	rest := template
+	for {
+		start := strings.Index(rest, "{")
+		if start < 0 {
+			builder.WriteString(rest)
+			return builder.String(), nil
+		}
+		end := strings.Index(rest, "}")
+		builder.WriteString(rest[:start])
+		builder.WriteString(EscapePathParam(params[rest[start+1:end]]))
+		rest = rest[end+1:]
+	}
+}
+
+// RouteParams returns the names of the {name} placeholders of the route template in order. An error is returned if a
+// placeholder is empty, unclosed or nested.
+func RouteParams(template string) ([]string, errors.EdgeX) {
+	var names []string
+	rest := template
+	for {
+		start := strings.IndexAny(rest, "{}")
+		if start < 0 {
+			return names, nil
+		}
+		end := strings.IndexAny(rest[start+1:], "{}") + start + 1
+		if rest[start] != '{' || end == start || rest[end] != '}' || end == start+1 {
+			return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("the route %s has an invalid placeholder", template), nil)
+		}
+		names = append(names, rest[start+1:end])
+		rest = rest[end+1:]
+	}
+}
+
+// EscapePathParam escapes the value so that it is a single path segment. The space is escaped as %20 instead of + so
+// that the value is decoded the same way by url.PathUnescape and url.QueryUnescape.
+func EscapePathParam(value string) string {
+	return strings.ReplaceAll(url.QueryEscape(value), "+", "%20")
+}
@@ -0,0 +1,95 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v2
+
+import (
+	"net/url"
+	"testing"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestExpandRoute(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		params   map[string]string
+		expected string
+	}{
+		{"no placeholder", ApiAllDeviceRoute, nil, "/api/v2/device/all"},
+		{"one placeholder", ApiDeviceByNameRoute, map[string]string{Name: "device1"}, "/api/v2/device/name/device1"},
+		{"placeholders", ApiDeviceProfileByManufacturerAndModelRoute, map[string]string{Manufacturer: "IOTech", Model: "m1"}, "/api/v2/deviceprofile/manufacturer/IOTech/model/m1"},
+		{"same names in other segments", ApiDeviceResourceByProfileAndResourceRoute, map[string]string{ProfileName: "p", ResourceName: "r"}, "/api/v2/deviceresource/profile/p/resource/r"},
+		{"escaped slash", ApiDeviceByNameRoute, map[string]string{Name: "a/b"}, "/api/v2/device/name/a%2Fb"},
+		{"escaped space and plus", ApiDeviceByNameRoute, map[string]string{Name: "a b+c"}, "/api/v2/device/name/a%20b%2Bc"},
+		{"escaped question mark", ApiDeviceByNameRoute, map[string]string{Name: "a?b#c"}, "/api/v2/device/name/a%3Fb%23c"},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			result, err := ExpandRoute(testCase.template, testCase.params)
+			require.NoError(t, err)
+			assert.Equal(t, testCase.expected, result)
+		})
+	}
+}
+
+func TestExpandRoute_Errors(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		params   map[string]string
+	}{
+		{"missing param", ApiDeviceNameCommandNameRoute, map[string]string{Name: "device1"}},
+		{"empty param", ApiDeviceByNameRoute, map[string]string{Name: ""}},
+		{"unknown param", ApiDeviceByNameRoute, map[string]string{Name: "device1", Id: "id"}},
+		{"param of a template without placeholder", ApiAllDeviceRoute, map[string]string{Name: "device1"}},
+		{"empty placeholder", ApiDeviceRoute + "/{}", nil},
+		{"unclosed placeholder", ApiDeviceRoute + "/{name", map[string]string{Name: "device1"}},
+		{"unopened placeholder", ApiDeviceRoute + "/name}", nil},
+		{"nested placeholder", ApiDeviceRoute + "/{a{name}}", map[string]string{Name: "device1"}},
+	}
+	for _, testCase := range tests {
+		t.Run(testCase.name, func(t *testing.T) {
+			_, err := ExpandRoute(testCase.template, testCase.params)
+			require.Error(t, err)
+			assert.Equal(t, errors.KindContractInvalid, errors.Kind(err))
+		})
+	}
+}
+
+func TestRouteBuilder(t *testing.T) {
+	result, err := NewRouteBuilder(ApiEventByTimeRangeRoute).SetIntParam(Start, 1).SetIntParam(End, 2).Build()
+	require.NoError(t, err)
+	assert.Equal(t, "/api/v2/event/start/1/end/2", result)
+
+	_, err = NewRouteBuilder(ApiEventByTimeRangeRoute).SetIntParam(Start, 1).Build()
+	require.Error(t, err)
+}
+
+func TestRouteParams(t *testing.T) {
+	names, err := RouteParams(ApiEventProfileNameDeviceNameSourceNameRoute)
+	require.NoError(t, err)
+	assert.Equal(t, []string{ProfileName, DeviceName, SourceName}, names)
+
+	names, err = RouteParams(ApiAllDeviceRoute)
+	require.NoError(t, err)
+	assert.Empty(t, names)
+}
+
+func TestEscapePathParam(t *testing.T) {
+	for _, value := range []string{"device 1", "a+b", "a/b", "100%", "ü", "a;b,c=d&e"} {
+		escaped := EscapePathParam(value)
+		pathUnescaped, err := url.PathUnescape(escaped)
+		require.NoError(t, err)
+		assert.Equal(t, value, pathUnescaped)
+		queryUnescaped, err := url.QueryUnescape(escaped)
+		require.NoError(t, err)
+		assert.Equal(t, value, queryUnescaped)
+	}
+}
@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+)
+
+// BatchItem is an undecoded item of a batch request
+type BatchItem struct {
+	// Index is the position of the item within the batch request
+	Index     int
+	RequestId string
+	data      []byte
+	unmarshal unmarshalFunc
+}
+
+// Decode unmarshals the item into v, e.g. a requests.AddDeviceRequest, which validates the item while being unmarshaled
+func (item BatchItem) Decode(v interface{}) errors.EdgeX {
+	return decode(item.data, v, item.unmarshal)
+}
+
+// BatchItemFunc processes an item of a batch request, and returns the id of the created or updated entity if any
+type BatchItemFunc func(r *http.Request, item BatchItem) (id string, err errors.EdgeX)
+
+// DecodeBatch reads the batch request body and splits it into the undecoded items, so that the failure of an item
+// doesn't fail the whole batch
+func (d Decoder) DecodeBatch(r *http.Request) ([]BatchItem, errors.EdgeX) {
+	unmarshal, err := unmarshalerOf(r)
+	if err != nil {
+		return nil, err
+	}
+	data, err := d.ReadBody(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var raws [][]byte
+	contentType, _ := ContentTypeOf(r)
+	if contentType == clients.ContentTypeCBOR {
+		var items []cbor.RawMessage
+		err = decode(data, &items, unmarshal)
+		for _, item := range items {
+			raws = append(raws, item)
+		}
+	} else {
+		var items []json.RawMessage
+		err = decode(data, &items, unmarshal)
+		for _, item := range items {
+			raws = append(raws, item)
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	items := make([]BatchItem, len(raws))
+	for i, raw := range raws {
+		items[i] = BatchItem{Index: i, data: raw, unmarshal: unmarshal}
+		// The request id is echoed in the response even if the item fails the validation
+		var base common.BaseRequest
+		if unmarshal(raw, &base) == nil {
+			items[i].RequestId = base.RequestId
+		}
+	}
+	return items, nil
+}
+
+// HandleBatch processes each item of the batch request with the handle function, and writes a []common.BaseResponse
+// with http.StatusMultiStatus, where each item carries the successStatusCode or the status code mapped from its error.
+// The whole request fails only if the body can't be decoded as a batch.
+func HandleBatch(w http.ResponseWriter, r *http.Request, d Decoder, successStatusCode int, handle BatchItemFunc) {
+	items, err := d.DecodeBatch(r)
+	if err != nil {
+		WriteError(w, r, "", err)
+		return
+	}
+	responses := make([]common.BaseResponse, len(items))
+	for i, item := range items {
+		_, err := handle(r, item)
+		responses[i] = batchItemResponse(item, successStatusCode, err)
+	}
+	WriteResponse(w, r, http.StatusMultiStatus, responses)
+}
+
+// HandleBatchWithId is the same as HandleBatch, but writes a []common.BaseWithIdResponse carrying the id returned by
+// the handle function, e.g. for the requests which create new entities
+func HandleBatchWithId(w http.ResponseWriter, r *http.Request, d Decoder, successStatusCode int, handle BatchItemFunc) {
+	items, err := d.DecodeBatch(r)
+	if err != nil {
+		WriteError(w, r, "", err)
+		return
+	}
+	responses := make([]common.BaseWithIdResponse, len(items))
+	for i, item := range items {
+		id, err := handle(r, item)
+		responses[i] = common.BaseWithIdResponse{BaseResponse: batchItemResponse(item, successStatusCode, err)}
+		if err == nil {
+			responses[i].Id = id
+		}
+	}
+	WriteResponse(w, r, http.StatusMultiStatus, responses)
+}
+
+func batchItemResponse(item BatchItem, successStatusCode int, err errors.EdgeX) common.BaseResponse {
+	if err != nil {
+		return common.NewBaseResponse(item.RequestId, err.Message(), StatusCode(err))
+	}
+	return common.NewBaseResponse(item.RequestId, "", successStatusCode)
+}
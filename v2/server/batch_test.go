@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+)
+
+// addEvents is a BatchItemFunc which accepts the events not yet added
+func addEvents(added map[string]bool) BatchItemFunc {
+	return func(_ *http.Request, item BatchItem) (string, errors.EdgeX) {
+		var req requests.AddEventRequest
+		if err := item.Decode(&req); err != nil {
+			return "", err
+		}
+		if added[req.Event.Id] {
+			return "", errors.NewCommonEdgeX(errors.KindDuplicateName, fmt.Sprintf("event %s exists", req.Event.Id), nil)
+		}
+		added[req.Event.Id] = true
+		return req.Event.Id, nil
+	}
+}
+
+func TestHandleBatchWithId(t *testing.T) {
+	valid := testAddEventRequest(t)
+	invalid := testAddEventRequest(t)
+	invalid.Event.ProfileName = ""
+	batch := []requests.AddEventRequest{valid, invalid, valid}
+
+	for _, contentType := range []string{clients.ContentTypeJSON, clients.ContentTypeCBOR} {
+		t.Run(contentType, func(t *testing.T) {
+			var body []byte
+			var err error
+			if contentType == clients.ContentTypeCBOR {
+				body, err = cbor.Marshal(batch)
+			} else {
+				body, err = json.Marshal(batch)
+			}
+			require.NoError(t, err)
+
+			w := httptest.NewRecorder()
+			HandleBatchWithId(w, newRequest(body, contentType), NewDecoder(DefaultMaxRequestSize), http.StatusCreated, addEvents(make(map[string]bool)))
+			require.Equal(t, http.StatusMultiStatus, w.Code)
+
+			var res []common.BaseWithIdResponse
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+			require.Len(t, res, 3)
+			assert.Equal(t, http.StatusCreated, res[0].StatusCode)
+			assert.Equal(t, valid.Event.Id, res[0].Id)
+			assert.Equal(t, valid.RequestId, res[0].RequestId)
+			assert.Equal(t, http.StatusBadRequest, res[1].StatusCode)
+			assert.Equal(t, invalid.RequestId, res[1].RequestId, "request id should be echoed for the invalid item")
+			assert.Empty(t, res[1].Id)
+			assert.Equal(t, http.StatusConflict, res[2].StatusCode)
+		})
+	}
+}
+
+func TestHandleBatch(t *testing.T) {
+	body, err := json.Marshal([]requests.AddEventRequest{testAddEventRequest(t), testAddEventRequest(t)})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	HandleBatch(w, newRequest(body, clients.ContentTypeJSON), NewDecoder(DefaultMaxRequestSize), http.StatusOK, addEvents(make(map[string]bool)))
+	require.Equal(t, http.StatusMultiStatus, w.Code)
+	var res []common.BaseResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &res))
+	require.Len(t, res, 2)
+	assert.Equal(t, http.StatusOK, res[0].StatusCode)
+	assert.Equal(t, http.StatusOK, res[1].StatusCode)
+}
+
+func TestHandleBatch_InvalidBody(t *testing.T) {
+	tests := []struct {
+		name           string
+		body           []byte
+		maxRequestSize int64
+		expectedStatus int
+	}{
+		{"not an array", []byte(`{"requestId":""}`), DefaultMaxRequestSize, http.StatusBadRequest},
+		{"malformed", []byte(`[{`), DefaultMaxRequestSize, http.StatusBadRequest},
+		{"too large", []byte(`[{},{}]`), 3, http.StatusRequestEntityTooLarge},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := httptest.NewRecorder()
+			HandleBatch(w, newRequest(tt.body, clients.ContentTypeJSON), NewDecoder(tt.maxRequestSize), http.StatusOK, addEvents(make(map[string]bool)))
+			assert.Equal(t, tt.expectedStatus, w.Code)
+		})
+	}
+}
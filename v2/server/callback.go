@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+)
+
+// DeviceServiceCallback defines the callbacks implemented by the device service, which mirrors the
+// interfaces.DeviceServiceCallbackClient used by core-metadata to invoke them.
+type DeviceServiceCallback interface {
+	// AddDeviceCallback handles the callback for adding device
+	AddDeviceCallback(ctx context.Context, request requests.AddDeviceRequest) errors.EdgeX
+	// UpdateDeviceCallback handles the callback for updating device
+	UpdateDeviceCallback(ctx context.Context, request requests.UpdateDeviceRequest) errors.EdgeX
+	// DeleteDeviceCallback handles the callback for deleting device
+	DeleteDeviceCallback(ctx context.Context, name string) errors.EdgeX
+	// UpdateDeviceProfileCallback handles the callback for updating device profile
+	UpdateDeviceProfileCallback(ctx context.Context, request requests.DeviceProfileRequest) errors.EdgeX
+	// AddProvisionWatcherCallback handles the callback for adding provision watcher
+	AddProvisionWatcherCallback(ctx context.Context, request requests.AddProvisionWatcherRequest) errors.EdgeX
+	// UpdateProvisionWatcherCallback handles the callback for updating provision watcher
+	UpdateProvisionWatcherCallback(ctx context.Context, request requests.UpdateProvisionWatcherRequest) errors.EdgeX
+	// DeleteProvisionWatcherCallback handles the callback for deleting provision watcher
+	DeleteProvisionWatcherCallback(ctx context.Context, name string) errors.EdgeX
+	// UpdateDeviceServiceCallback handles the callback for updating device service
+	UpdateDeviceServiceCallback(ctx context.Context, request requests.UpdateDeviceServiceRequest) errors.EdgeX
+}
+
+// CallbackDispatcher serves the device service callback routes, decodes the callback requests and dispatches them to
+// the DeviceServiceCallback
+type CallbackDispatcher struct {
+	callback DeviceServiceCallback
+	decoder  Decoder
+}
+
+// NewCallbackDispatcher creates a CallbackDispatcher dispatching the callbacks to the specified DeviceServiceCallback
+func NewCallbackDispatcher(callback DeviceServiceCallback) *CallbackDispatcher {
+	return &CallbackDispatcher{callback: callback, decoder: NewDecoder(DefaultMaxRequestSize)}
+}
+
+// Mount registers the CallbackDispatcher to the callback routes of the ServeMux
+func (d *CallbackDispatcher) Mount(mux *http.ServeMux) {
+	mux.Handle(v2.ApiDeviceCallbackRoute, d)
+	mux.Handle(namePrefix(v2.ApiDeviceCallbackNameRoute), d)
+	mux.Handle(v2.ApiProfileCallbackRoute, d)
+	mux.Handle(v2.ApiWatcherCallbackRoute, d)
+	mux.Handle(namePrefix(v2.ApiWatcherCallbackNameRoute), d)
+	mux.Handle(v2.ApiServiceCallbackRoute, d)
+}
+
+// ServeHTTP dispatches the callback request according to its path and method
+func (d *CallbackDispatcher) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	var requestId string
+	var err errors.EdgeX
+	switch p := r.URL.Path; {
+	case p == v2.ApiDeviceCallbackRoute:
+		requestId, err = d.dispatchDevice(r)
+	case p == v2.ApiProfileCallbackRoute:
+		requestId, err = d.dispatchDeviceProfile(r)
+	case p == v2.ApiWatcherCallbackRoute:
+		requestId, err = d.dispatchProvisionWatcher(r)
+	case p == v2.ApiServiceCallbackRoute:
+		requestId, err = d.dispatchDeviceService(r)
+	case strings.HasPrefix(p, namePrefix(v2.ApiDeviceCallbackNameRoute)):
+		err = d.dispatchDelete(r, namePrefix(v2.ApiDeviceCallbackNameRoute), d.callback.DeleteDeviceCallback)
+	case strings.HasPrefix(p, namePrefix(v2.ApiWatcherCallbackNameRoute)):
+		err = d.dispatchDelete(r, namePrefix(v2.ApiWatcherCallbackNameRoute), d.callback.DeleteProvisionWatcherCallback)
+	default:
+		err = errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, fmt.Sprintf("route %s not found", p), nil)
+	}
+	if err != nil {
+		WriteError(w, r, requestId, err)
+		return
+	}
+	WriteResponse(w, r, http.StatusOK, common.NewBaseResponse(requestId, "", http.StatusOK))
+}
+
+func (d *CallbackDispatcher) dispatchDevice(r *http.Request) (string, errors.EdgeX) {
+	switch r.Method {
+	case http.MethodPost:
+		var req requests.AddDeviceRequest
+		if err := d.decoder.Decode(r, &req); err != nil {
+			return "", err
+		}
+		return req.RequestId, d.callback.AddDeviceCallback(r.Context(), req)
+	case http.MethodPut:
+		var req requests.UpdateDeviceRequest
+		if err := d.decoder.Decode(r, &req); err != nil {
+			return "", err
+		}
+		return req.RequestId, d.callback.UpdateDeviceCallback(r.Context(), req)
+	default:
+		return "", methodNotAllowedError(r)
+	}
+}
+
+func (d *CallbackDispatcher) dispatchDeviceProfile(r *http.Request) (string, errors.EdgeX) {
+	if r.Method != http.MethodPut {
+		return "", methodNotAllowedError(r)
+	}
+	var req requests.DeviceProfileRequest
+	if err := d.decoder.Decode(r, &req); err != nil {
+		return "", err
+	}
+	return req.RequestId, d.callback.UpdateDeviceProfileCallback(r.Context(), req)
+}
+
+func (d *CallbackDispatcher) dispatchProvisionWatcher(r *http.Request) (string, errors.EdgeX) {
+	switch r.Method {
+	case http.MethodPost:
+		var req requests.AddProvisionWatcherRequest
+		if err := d.decoder.Decode(r, &req); err != nil {
+			return "", err
+		}
+		return req.RequestId, d.callback.AddProvisionWatcherCallback(r.Context(), req)
+	case http.MethodPut:
+		var req requests.UpdateProvisionWatcherRequest
+		if err := d.decoder.Decode(r, &req); err != nil {
+			return "", err
+		}
+		return req.RequestId, d.callback.UpdateProvisionWatcherCallback(r.Context(), req)
+	default:
+		return "", methodNotAllowedError(r)
+	}
+}
+
+func (d *CallbackDispatcher) dispatchDeviceService(r *http.Request) (string, errors.EdgeX) {
+	if r.Method != http.MethodPut {
+		return "", methodNotAllowedError(r)
+	}
+	var req requests.UpdateDeviceServiceRequest
+	if err := d.decoder.Decode(r, &req); err != nil {
+		return "", err
+	}
+	return req.RequestId, d.callback.UpdateDeviceServiceCallback(r.Context(), req)
+}
+
+func (d *CallbackDispatcher) dispatchDelete(r *http.Request, prefix string, deleteFunc func(context.Context, string) errors.EdgeX) errors.EdgeX {
+	if r.Method != http.MethodDelete {
+		return methodNotAllowedError(r)
+	}
+	name := strings.TrimPrefix(r.URL.Path, prefix)
+	if name == "" || strings.Contains(name, "/") {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid name in the route %s", r.URL.Path), nil)
+	}
+	return deleteFunc(r.Context(), name)
+}
+
+// namePrefix returns the route prefix before the {name} placeholder, e.g. /api/v2/callback/device/name/
+func namePrefix(route string) string {
+	return strings.TrimSuffix(route, "{"+v2.Name+"}")
+}
+
+func methodNotAllowedError(r *http.Request) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindNotAllowed, fmt.Sprintf("method %s is not allowed for the route %s", r.Method, r.URL.Path), nil)
+}
@@ -0,0 +1,168 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	clientHttp "github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients/http"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/models"
+)
+
+// recordingCallback records the name of the invoked callback and its argument
+type recordingCallback struct {
+	invoked  string
+	argument interface{}
+	err      errors.EdgeX
+}
+
+func (c *recordingCallback) record(invoked string, argument interface{}) errors.EdgeX {
+	c.invoked, c.argument = invoked, argument
+	return c.err
+}
+
+func (c *recordingCallback) AddDeviceCallback(_ context.Context, request requests.AddDeviceRequest) errors.EdgeX {
+	return c.record("AddDeviceCallback", request.Device.Name)
+}
+
+func (c *recordingCallback) UpdateDeviceCallback(_ context.Context, request requests.UpdateDeviceRequest) errors.EdgeX {
+	return c.record("UpdateDeviceCallback", *request.Device.Name)
+}
+
+func (c *recordingCallback) DeleteDeviceCallback(_ context.Context, name string) errors.EdgeX {
+	return c.record("DeleteDeviceCallback", name)
+}
+
+func (c *recordingCallback) UpdateDeviceProfileCallback(_ context.Context, request requests.DeviceProfileRequest) errors.EdgeX {
+	return c.record("UpdateDeviceProfileCallback", request.Profile.Name)
+}
+
+func (c *recordingCallback) AddProvisionWatcherCallback(_ context.Context, request requests.AddProvisionWatcherRequest) errors.EdgeX {
+	return c.record("AddProvisionWatcherCallback", request.ProvisionWatcher.Name)
+}
+
+func (c *recordingCallback) UpdateProvisionWatcherCallback(_ context.Context, request requests.UpdateProvisionWatcherRequest) errors.EdgeX {
+	return c.record("UpdateProvisionWatcherCallback", *request.ProvisionWatcher.Name)
+}
+
+func (c *recordingCallback) DeleteProvisionWatcherCallback(_ context.Context, name string) errors.EdgeX {
+	return c.record("DeleteProvisionWatcherCallback", name)
+}
+
+func (c *recordingCallback) UpdateDeviceServiceCallback(_ context.Context, request requests.UpdateDeviceServiceRequest) errors.EdgeX {
+	return c.record("UpdateDeviceServiceCallback", *request.Service.Name)
+}
+
+func newCallbackServer(callback DeviceServiceCallback) *httptest.Server {
+	mux := http.NewServeMux()
+	NewCallbackDispatcher(callback).Mount(mux)
+	return httptest.NewServer(mux)
+}
+
+func TestCallbackDispatcher(t *testing.T) {
+	callback := &recordingCallback{}
+	ts := newCallbackServer(callback)
+	defer ts.Close()
+	client := clientHttp.NewDeviceServiceCallbackClient(ts.URL)
+	ctx := context.Background()
+
+	name := "test"
+	device := dtos.Device{Name: name, AdminState: models.Unlocked, OperatingState: models.Up, ServiceName: "service", ProfileName: "profile",
+		Protocols: map[string]dtos.ProtocolProperties{"other": {"Address": "localhost"}}}
+	profile := dtos.DeviceProfile{Name: name, DeviceResources: []dtos.DeviceResource{
+		{Name: "resource", Properties: dtos.ResourceProperties{ValueType: v2.ValueTypeInt16, ReadWrite: v2.ReadWrite_R}}}}
+	watcher := dtos.ProvisionWatcher{Name: name, Identifiers: map[string]string{"address": "localhost"}, ServiceName: "service",
+		ProfileName: "profile", AdminState: models.Unlocked}
+
+	tests := []struct {
+		expected string
+		invoke   func() error
+	}{
+		{"AddDeviceCallback", func() error {
+			_, err := client.AddDeviceCallback(ctx, requests.NewAddDeviceRequest(device))
+			return err
+		}},
+		{"UpdateDeviceCallback", func() error {
+			_, err := client.UpdateDeviceCallback(ctx, requests.NewUpdateDeviceRequest(dtos.UpdateDevice{Name: &name}))
+			return err
+		}},
+		{"DeleteDeviceCallback", func() error {
+			_, err := client.DeleteDeviceCallback(ctx, name)
+			return err
+		}},
+		{"UpdateDeviceProfileCallback", func() error {
+			_, err := client.UpdateDeviceProfileCallback(ctx, requests.NewDeviceProfileRequest(profile))
+			return err
+		}},
+		{"AddProvisionWatcherCallback", func() error {
+			_, err := client.AddProvisionWatcherCallback(ctx, requests.NewAddProvisionWatcherRequest(watcher))
+			return err
+		}},
+		{"UpdateProvisionWatcherCallback", func() error {
+			_, err := client.UpdateProvisionWatcherCallback(ctx, requests.NewUpdateProvisionWatcherRequest(dtos.UpdateProvisionWatcher{Name: &name}))
+			return err
+		}},
+		{"DeleteProvisionWatcherCallback", func() error {
+			_, err := client.DeleteProvisionWatcherCallback(ctx, name)
+			return err
+		}},
+		{"UpdateDeviceServiceCallback", func() error {
+			_, err := client.UpdateDeviceServiceCallback(ctx, requests.NewUpdateDeviceServiceRequest(dtos.UpdateDeviceService{Name: &name}))
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.expected, func(t *testing.T) {
+			callback.invoked, callback.argument = "", nil
+			require.NoError(t, tt.invoke())
+			assert.Equal(t, tt.expected, callback.invoked)
+			assert.Equal(t, name, callback.argument)
+		})
+	}
+}
+
+func TestCallbackDispatcher_Errors(t *testing.T) {
+	callback := &recordingCallback{}
+	ts := newCallbackServer(callback)
+	defer ts.Close()
+
+	tests := []struct {
+		name           string
+		method         string
+		route          string
+		body           string
+		callbackErr    errors.EdgeX
+		expectedStatus int
+	}{
+		{"invalid request", http.MethodPost, v2.ApiDeviceCallbackRoute, `{"apiVersion":"v2","device":{}}`, nil, http.StatusBadRequest},
+		{"method not allowed", http.MethodDelete, v2.ApiProfileCallbackRoute, "", nil, http.StatusMethodNotAllowed},
+		{"missing name", http.MethodDelete, namePrefix(v2.ApiDeviceCallbackNameRoute), "", nil, http.StatusBadRequest},
+		{"callback failed", http.MethodDelete, namePrefix(v2.ApiWatcherCallbackNameRoute) + "test", "",
+			errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "not found", nil), http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			callback.err = tt.callbackErr
+			req, err := http.NewRequest(tt.method, ts.URL+tt.route, strings.NewReader(tt.body))
+			require.NoError(t, err)
+			res, err := http.DefaultClient.Do(req)
+			require.NoError(t, err)
+			defer res.Body.Close()
+			assert.Equal(t, tt.expectedStatus, res.StatusCode)
+		})
+	}
+}
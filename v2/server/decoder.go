@@ -0,0 +1,119 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+// Package server provides the helpers for the EdgeX services to serve the v2 DTOs over HTTP, which decode the request
+// bodies, encode the responses and map the EdgeX errors to the HTTP status codes.
+package server
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"net/http"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+)
+
+// DefaultMaxRequestSize is the default maximum size in bytes of a request body
+const DefaultMaxRequestSize int64 = 16 * 1024 * 1024
+
+type unmarshalFunc func([]byte, interface{}) error
+
+// Decoder decodes the JSON or CBOR request bodies into the request DTOs. The request DTOs which implement
+// json.Unmarshaler or cbor.Unmarshaler, e.g. requests.AddEventRequest, are validated while being unmarshaled.
+type Decoder struct {
+	// MaxRequestSize is the maximum size in bytes of a request body, where zero or a negative value means no limit
+	MaxRequestSize int64
+}
+
+// NewDecoder creates a Decoder with the specified maximum request size
+func NewDecoder(maxRequestSize int64) Decoder {
+	return Decoder{MaxRequestSize: maxRequestSize}
+}
+
+// ContentTypeOf returns the media type of the request body, which defaults to JSON if the Content-Type header is absent
+func ContentTypeOf(r *http.Request) (string, errors.EdgeX) {
+	contentType := r.Header.Get(clients.ContentType)
+	if contentType == "" {
+		return clients.ContentTypeJSON, nil
+	}
+	mediaType, _, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("invalid Content-Type %s", contentType), err)
+	}
+	return mediaType, nil
+}
+
+// ReadBody reads the request body, and fails with errors.KindLimitExceeded if the body exceeds the maximum size
+func (d Decoder) ReadBody(r *http.Request) ([]byte, errors.EdgeX) {
+	defer r.Body.Close()
+	var reader io.Reader = r.Body
+	if d.MaxRequestSize > 0 {
+		if r.ContentLength > d.MaxRequestSize {
+			return nil, limitExceededError(d.MaxRequestSize)
+		}
+		// Read one more byte than the limit to detect the oversized body without a Content-Length
+		reader = io.LimitReader(r.Body, d.MaxRequestSize+1)
+	}
+	data, err := ioutil.ReadAll(reader)
+	if err != nil {
+		return nil, errors.NewCommonEdgeX(errors.KindIOError, "failed to read the request body", err)
+	}
+	if d.MaxRequestSize > 0 && int64(len(data)) > d.MaxRequestSize {
+		return nil, limitExceededError(d.MaxRequestSize)
+	}
+	return data, nil
+}
+
+// Decode reads the request body and unmarshals it into v according to the Content-Type of the request
+func (d Decoder) Decode(r *http.Request, v interface{}) errors.EdgeX {
+	unmarshal, err := unmarshalerOf(r)
+	if err != nil {
+		return err
+	}
+	data, err := d.ReadBody(r)
+	if err != nil {
+		return err
+	}
+	return decode(data, v, unmarshal)
+}
+
+func unmarshalerOf(r *http.Request) (unmarshalFunc, errors.EdgeX) {
+	contentType, err := ContentTypeOf(r)
+	if err != nil {
+		return nil, err
+	}
+	switch contentType {
+	case clients.ContentTypeJSON:
+		return json.Unmarshal, nil
+	case clients.ContentTypeCBOR:
+		return cbor.Unmarshal, nil
+	default:
+		return nil, errors.NewCommonEdgeX(errors.KindContractInvalid, fmt.Sprintf("unsupported Content-Type %s", contentType), nil)
+	}
+}
+
+func decode(data []byte, v interface{}, unmarshal unmarshalFunc) errors.EdgeX {
+	if len(data) == 0 {
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "request body is empty", nil)
+	}
+	if err := unmarshal(data, v); err != nil {
+		// The errors returned by the DTO unmarshalers already carry the error kind, e.g. a validation failure
+		if errors.Kind(err) != errors.KindUnknown {
+			return errors.NewCommonEdgeXWrapper(err)
+		}
+		return errors.NewCommonEdgeX(errors.KindContractInvalid, "failed to decode the request body", err)
+	}
+	return nil
+}
+
+func limitExceededError(maxRequestSize int64) errors.EdgeX {
+	return errors.NewCommonEdgeX(errors.KindLimitExceeded, fmt.Sprintf("request body exceeds the maximum size %d bytes", maxRequestSize), nil)
+}
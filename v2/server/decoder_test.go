@@ -0,0 +1,120 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/requests"
+)
+
+func testAddEventRequest(t *testing.T) requests.AddEventRequest {
+	event := dtos.NewEvent("profile", "device", "source")
+	require.NoError(t, event.AddSimpleReading("temperature", v2.ValueTypeInt16, int16(25)))
+	return requests.NewAddEventRequest(event)
+}
+
+func newRequest(body []byte, contentType string) *http.Request {
+	r := httptest.NewRequest(http.MethodPost, v2.ApiEventRoute, bytes.NewReader(body))
+	if contentType != "" {
+		r.Header.Set(clients.ContentType, contentType)
+	}
+	return r
+}
+
+func TestContentTypeOf(t *testing.T) {
+	tests := []struct {
+		name        string
+		contentType string
+		expected    string
+		expectError bool
+	}{
+		{"default to JSON", "", clients.ContentTypeJSON, false},
+		{"JSON with charset", "application/json; charset=utf-8", clients.ContentTypeJSON, false},
+		{"CBOR", clients.ContentTypeCBOR, clients.ContentTypeCBOR, false},
+		{"invalid", "application/json; =", "", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := ContentTypeOf(newRequest(nil, tt.contentType))
+			if tt.expectError {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestDecoder_Decode(t *testing.T) {
+	valid := testAddEventRequest(t)
+	jsonData, err := json.Marshal(valid)
+	require.NoError(t, err)
+	cborData, err := cbor.Marshal(valid)
+	require.NoError(t, err)
+	invalid := testAddEventRequest(t)
+	invalid.Event.DeviceName = ""
+	invalidData, err := json.Marshal(invalid)
+	require.NoError(t, err)
+
+	tests := []struct {
+		name           string
+		maxRequestSize int64
+		body           []byte
+		contentType    string
+		expectedKind   errors.ErrKind
+	}{
+		{"valid, JSON", 0, jsonData, clients.ContentTypeJSON, ""},
+		{"valid, JSON without Content-Type", 0, jsonData, "", ""},
+		{"valid, CBOR", 0, cborData, clients.ContentTypeCBOR, ""},
+		{"valid, within the size limit", int64(len(jsonData)), jsonData, clients.ContentTypeJSON, ""},
+		{"invalid, exceeds the size limit", int64(len(jsonData) - 1), jsonData, clients.ContentTypeJSON, errors.KindLimitExceeded},
+		{"invalid, unsupported Content-Type", 0, jsonData, "application/xml", errors.KindContractInvalid},
+		{"invalid, empty body", 0, nil, clients.ContentTypeJSON, errors.KindContractInvalid},
+		{"invalid, malformed JSON", 0, []byte("{"), clients.ContentTypeJSON, errors.KindContractInvalid},
+		{"invalid, CBOR body with JSON Content-Type", 0, cborData, clients.ContentTypeJSON, errors.KindContractInvalid},
+		{"invalid, DTO validation failed", 0, invalidData, clients.ContentTypeJSON, errors.KindContractInvalid},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var result requests.AddEventRequest
+			err := NewDecoder(tt.maxRequestSize).Decode(newRequest(tt.body, tt.contentType), &result)
+			if tt.expectedKind != "" {
+				require.Error(t, err)
+				assert.Equal(t, tt.expectedKind, errors.Kind(err))
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, valid.Event.Id, result.Event.Id)
+			assert.Equal(t, valid.Event.Readings[0].Value, result.Event.Readings[0].Value)
+		})
+	}
+}
+
+func TestDecoder_ReadBodyWithoutContentLength(t *testing.T) {
+	r := newRequest(nil, clients.ContentTypeJSON)
+	r.Body = ioutil.NopCloser(strings.NewReader("0123456789"))
+	r.ContentLength = -1
+
+	_, err := NewDecoder(5).ReadBody(r)
+	require.Error(t, err)
+	assert.Equal(t, errors.KindLimitExceeded, errors.Kind(err))
+}
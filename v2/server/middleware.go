@@ -0,0 +1,31 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net/http"
+
+	"github.com/google/uuid"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+)
+
+// CorrelationIDMiddleware extracts the Correlation ID from the X-Correlation-ID header of the request, or generates a
+// new one if absent, then stores it in the request context and echoes it in the response header, so that the
+// downstream handlers and the v2 clients called with the request context propagate the same Correlation ID.
+func CorrelationIDMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		correlationId := r.Header.Get(clients.CorrelationHeader)
+		if correlationId == "" {
+			correlationId = uuid.NewString()
+		}
+		// The request is cloned with its header, so the generated Correlation ID doesn't change the caller's request
+		r = r.Clone(clients.WithCorrelationID(r.Context(), correlationId))
+		r.Header.Set(clients.CorrelationHeader, correlationId)
+		w.Header().Set(clients.CorrelationHeader, correlationId)
+		next.ServeHTTP(w, r)
+	})
+}
@@ -0,0 +1,51 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+)
+
+func TestCorrelationIDMiddleware(t *testing.T) {
+	var fromContext string
+	handler := CorrelationIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fromContext = clients.CorrelationIDFrom(r.Context())
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, v2.ApiPingRoute, nil)
+	r.Header.Set(clients.CorrelationHeader, "correlationId")
+	w := httptest.NewRecorder()
+	handler.ServeHTTP(w, r)
+	assert.Equal(t, "correlationId", fromContext)
+	assert.Equal(t, "correlationId", w.Header().Get(clients.CorrelationHeader))
+
+	w = httptest.NewRecorder()
+	handler.ServeHTTP(w, httptest.NewRequest(http.MethodGet, v2.ApiPingRoute, nil))
+	_, err := uuid.Parse(fromContext)
+	require.NoError(t, err, "Correlation ID should be generated if absent")
+	assert.Equal(t, fromContext, w.Header().Get(clients.CorrelationHeader))
+}
+
+func TestCorrelationIDMiddleware_CallerRequestUnchanged(t *testing.T) {
+	var fromHeader string
+	handler := CorrelationIDMiddleware(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fromHeader = r.Header.Get(clients.CorrelationHeader)
+	}))
+
+	r := httptest.NewRequest(http.MethodGet, v2.ApiPingRoute, nil)
+	handler.ServeHTTP(httptest.NewRecorder(), r)
+	assert.NotEmpty(t, fromHeader, "the downstream handler should see the generated Correlation ID")
+	assert.Empty(t, r.Header.Get(clients.CorrelationHeader))
+}
@@ -0,0 +1,88 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	"mime"
+	"net/http"
+	"strings"
+
+	"github.com/fxamacker/cbor/v2"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+)
+
+// StatusCode maps the error to the HTTP status code, which is http.StatusOK for the nil error. The errors.EdgeX
+// errors without a valid HTTP status code, e.g. errors.KindClientError, are mapped to http.StatusBadRequest or
+// http.StatusInternalServerError, and other errors to http.StatusInternalServerError.
+func StatusCode(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	e, ok := err.(errors.EdgeX)
+	if !ok {
+		return http.StatusInternalServerError
+	}
+	if code := e.Code(); code >= http.StatusContinue {
+		return code
+	}
+	if errors.Kind(err) == errors.KindClientError {
+		return http.StatusBadRequest
+	}
+	return http.StatusInternalServerError
+}
+
+// ResponseContentType returns the content type of the response according to the Accept header of the request, where
+// CBOR is only used if explicitly accepted and JSON is used otherwise
+func ResponseContentType(r *http.Request) string {
+	for _, accepted := range strings.Split(r.Header.Get(clients.Accept), ",") {
+		mediaType, _, err := mime.ParseMediaType(strings.TrimSpace(accepted))
+		if err != nil {
+			continue
+		}
+		switch mediaType {
+		case clients.ContentTypeJSON:
+			return clients.ContentTypeJSON
+		case clients.ContentTypeCBOR:
+			return clients.ContentTypeCBOR
+		}
+	}
+	return clients.ContentTypeJSON
+}
+
+// WriteResponse encodes the response according to the Accept header of the request, and writes it with the status
+// code. The Correlation ID of the request is copied to the response.
+func WriteResponse(w http.ResponseWriter, r *http.Request, statusCode int, response interface{}) {
+	contentType := ResponseContentType(r)
+	var data []byte
+	var err error
+	if contentType == clients.ContentTypeCBOR {
+		data, err = cbor.Marshal(response)
+	} else {
+		data, err = json.Marshal(response)
+	}
+	if err != nil {
+		// Fall back to a JSON BaseResponse, which never fails to marshal
+		contentType, statusCode = clients.ContentTypeJSON, http.StatusInternalServerError
+		data, _ = json.Marshal(common.NewBaseResponse("", "failed to encode the response: "+err.Error(), statusCode))
+	}
+
+	if correlationId := r.Header.Get(clients.CorrelationHeader); correlationId != "" {
+		w.Header().Set(clients.CorrelationHeader, correlationId)
+	}
+	w.Header().Set(clients.ContentType, contentType)
+	w.WriteHeader(statusCode)
+	_, _ = w.Write(data)
+}
+
+// WriteError writes the error as a BaseResponse with the status code mapped from the error
+func WriteError(w http.ResponseWriter, r *http.Request, requestId string, err errors.EdgeX) {
+	statusCode := StatusCode(err)
+	WriteResponse(w, r, statusCode, common.NewBaseResponse(requestId, err.Message(), statusCode))
+}
@@ -0,0 +1,107 @@
+//
+// Copyright (C) 2021 IOTech Ltd
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package server
+
+import (
+	"encoding/json"
+	goErrors "errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/errors"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/clients"
+	"github.com/edgexfoundry/go-mod-core-contracts/v2/v2/dtos/common"
+)
+
+func TestStatusCode(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		expected int
+	}{
+		{"nil error", nil, http.StatusOK},
+		{"not found", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "", nil), http.StatusNotFound},
+		{"wrapped conflict", errors.NewCommonEdgeXWrapper(errors.NewCommonEdgeX(errors.KindDuplicateName, "", nil)), http.StatusConflict},
+		{"locked", errors.NewCommonEdgeX(errors.KindServiceLocked, "", nil), http.StatusLocked},
+		{"limit exceeded", errors.NewCommonEdgeX(errors.KindLimitExceeded, "", nil), http.StatusRequestEntityTooLarge},
+		{"client error", errors.NewCommonEdgeX(errors.KindClientError, "", nil), http.StatusBadRequest},
+		{"IO error", errors.NewCommonEdgeX(errors.KindIOError, "", nil), http.StatusInternalServerError},
+		{"non-EdgeX error", goErrors.New("failed"), http.StatusInternalServerError},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, StatusCode(tt.err))
+		})
+	}
+}
+
+func TestResponseContentType(t *testing.T) {
+	tests := []struct {
+		name     string
+		accept   string
+		expected string
+	}{
+		{"no Accept", "", clients.ContentTypeJSON},
+		{"any", "*/*", clients.ContentTypeJSON},
+		{"CBOR", clients.ContentTypeCBOR, clients.ContentTypeCBOR},
+		{"CBOR preferred", "application/cbor, application/json;q=0.9", clients.ContentTypeCBOR},
+		{"JSON preferred", "text/html, application/json, application/cbor", clients.ContentTypeJSON},
+		{"unsupported", "application/xml", clients.ContentTypeJSON},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := httptest.NewRequest(http.MethodGet, v2.ApiPingRoute, nil)
+			if tt.accept != "" {
+				r.Header.Set(clients.Accept, tt.accept)
+			}
+			assert.Equal(t, tt.expected, ResponseContentType(r))
+		})
+	}
+}
+
+func TestWriteResponse(t *testing.T) {
+	response := common.NewBaseWithIdResponse("requestId", "", http.StatusCreated, "id")
+
+	r := httptest.NewRequest(http.MethodPost, v2.ApiDeviceRoute, nil)
+	r.Header.Set(clients.CorrelationHeader, "correlationId")
+	w := httptest.NewRecorder()
+	WriteResponse(w, r, http.StatusCreated, response)
+	assert.Equal(t, http.StatusCreated, w.Code)
+	assert.Equal(t, clients.ContentTypeJSON, w.Header().Get(clients.ContentType))
+	assert.Equal(t, "correlationId", w.Header().Get(clients.CorrelationHeader))
+	var jsonResult common.BaseWithIdResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &jsonResult))
+	assert.Equal(t, response, jsonResult)
+
+	r.Header.Set(clients.Accept, clients.ContentTypeCBOR)
+	w = httptest.NewRecorder()
+	WriteResponse(w, r, http.StatusCreated, response)
+	assert.Equal(t, clients.ContentTypeCBOR, w.Header().Get(clients.ContentType))
+	var cborResult common.BaseWithIdResponse
+	require.NoError(t, cbor.Unmarshal(w.Body.Bytes(), &cborResult))
+	assert.Equal(t, response, cborResult)
+
+	w = httptest.NewRecorder()
+	WriteResponse(w, httptest.NewRequest(http.MethodGet, v2.ApiPingRoute, nil), http.StatusOK, make(chan int))
+	assert.Equal(t, http.StatusInternalServerError, w.Code, "unencodable response should fail with 500")
+}
+
+func TestWriteError(t *testing.T) {
+	r := httptest.NewRequest(http.MethodGet, v2.ApiDeviceRoute, nil)
+	w := httptest.NewRecorder()
+	WriteError(w, r, "requestId", errors.NewCommonEdgeX(errors.KindEntityDoesNotExist, "device not found", nil))
+
+	assert.Equal(t, http.StatusNotFound, w.Code)
+	var result common.BaseResponse
+	require.NoError(t, json.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, common.NewBaseResponse("requestId", "device not found", http.StatusNotFound), result)
+}
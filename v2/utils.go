@@ -24,6 +24,11 @@ var valueTypes = []string{
 	ValueTypeFloat32Array, ValueTypeFloat64Array,
 }
 
+// ValueTypes returns all the supported value types in upper camel case
+func ValueTypes() []string {
+	return append([]string(nil), valueTypes...)
+}
+
 // // NormalizeValueType normalizes the valueType to upper camel case
 func NormalizeValueType(valueType string) (string, error) {
 	for _, v := range valueTypes {
@@ -27,16 +27,22 @@ const (
 	dtoValueType                = "edgex-dto-value-type"
 	dtoRFC3986UnreservedCharTag = "edgex-dto-rfc3986-unreserved-chars"
 	dtoInterDatetimeTag         = "edgex-dto-interval-datetime"
+	dtoMQTTPublishTopicTag      = "edgex-dto-mqtt-publish-topic"
+	dtoHTTPHeaderNameTag        = "edgex-dto-http-header-name"
+	dtoRegexTag                 = "edgex-dto-regex"
 )
 
 const (
 	// Per https://tools.ietf.org/html/rfc3986#section-2.3, unreserved characters= ALPHA / DIGIT / "-" / "." / "_" / "~"
 	rFC3986UnreservedCharsRegexString = "^[a-zA-Z0-9-_.~]+$"
 	intervalDatetimeLayout            = "20060102T150405"
+	// Per https://tools.ietf.org/html/rfc7230#section-3.2.6, field-name = token = 1*tchar
+	hTTPHeaderNameRegexString = "^[a-zA-Z0-9!#$%&'*+\\-.^_`|~]+$"
 )
 
 var (
 	rFC3986UnreservedCharsRegex = regexp.MustCompile(rFC3986UnreservedCharsRegexString)
+	hTTPHeaderNameRegex         = regexp.MustCompile(hTTPHeaderNameRegexString)
 )
 
 func init() {
@@ -47,12 +53,24 @@ func init() {
 	val.RegisterValidation(dtoValueType, ValidateValueType)
 	val.RegisterValidation(dtoRFC3986UnreservedCharTag, ValidateDtoRFC3986UnreservedChars)
 	val.RegisterValidation(dtoInterDatetimeTag, ValidateIntervalDatetime)
+	val.RegisterValidation(dtoMQTTPublishTopicTag, ValidateMQTTPublishTopic)
+	val.RegisterValidation(dtoHTTPHeaderNameTag, ValidateHTTPHeaderName)
+	val.RegisterValidation(dtoRegexTag, ValidateRegex)
 }
 
 // Validate function will use the validator package to validate the struct annotation
 func Validate(a interface{}) error {
-	err := val.Struct(a)
-	// translate all error at once
+	return translateErrors(val.Struct(a))
+}
+
+// ValidateFields validates the struct annotation of the fields of the Go names only, e.g. the fields changed by a
+// partial update of a struct whose other fields were validated before
+func ValidateFields(a interface{}, fields ...string) error {
+	return translateErrors(val.StructPartial(a, fields...))
+}
+
+// translateErrors translates all the validation errors at once
+func translateErrors(err error) error {
 	if err != nil {
 		errs := err.(validator.ValidationErrors)
 		var errMsg []string
@@ -84,6 +102,10 @@ func getErrorMessage(e validator.FieldError) string {
 		msg = fmt.Sprintf("%s field should be one of %s", fieldName, fieldValue)
 	case "gt":
 		msg = fmt.Sprintf("%s field should greater than %s", fieldName, fieldValue)
+	case "min":
+		msg = fmt.Sprintf("%s field should not be less than %s", fieldName, fieldValue)
+	case "max":
+		msg = fmt.Sprintf("%s field should not be greater than %s", fieldName, fieldValue)
 	case dtoDurationTag:
 		msg = fmt.Sprintf("%s field should follows the ISO 8601 Durations format. Eg,100ms, 24h", fieldName)
 	case dtoUuidTag:
@@ -92,6 +114,12 @@ func getErrorMessage(e validator.FieldError) string {
 		msg = fmt.Sprintf("%s field should not be empty string", fieldName)
 	case dtoRFC3986UnreservedCharTag:
 		msg = fmt.Sprintf("%s field only allows unreserved characters as defined in https://tools.ietf.org/html/rfc3986#section-2.3, which should be ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz0123456789-_.~", fieldName)
+	case dtoMQTTPublishTopicTag:
+		msg = fmt.Sprintf("%s field should be a valid MQTT topic name without the wildcard characters '+' and '#'", fieldName)
+	case dtoHTTPHeaderNameTag:
+		msg = fmt.Sprintf("%s field should be a valid HTTP header name as defined in https://tools.ietf.org/html/rfc7230#section-3.2", fieldName)
+	case dtoRegexTag:
+		msg = fmt.Sprintf("%s field should be a valid regular expression, but got %s", fieldName, e.Value())
 	default:
 		msg = fmt.Sprintf("%s field validation failed on the %s tag", fieldName, tag)
 	}
@@ -160,3 +188,25 @@ func ValidateIntervalDatetime(fl validator.FieldLevel) bool {
 	_, err := time.Parse(intervalDatetimeLayout, fl.Field().String())
 	return err == nil
 }
+
+// ValidateMQTTPublishTopic checks whether the field is a valid MQTT topic name to publish to.
+// Per the MQTT specification, the wildcard characters '+' and '#' are only allowed in topic filters used by
+// subscribers, so a publisher topic must not contain them.
+func ValidateMQTTPublishTopic(fl validator.FieldLevel) bool {
+	topic := fl.Field().String()
+	if len(topic) == 0 {
+		return false
+	}
+	return !strings.ContainsAny(topic, "+#\x00")
+}
+
+// ValidateHTTPHeaderName checks whether the field is a valid HTTP header field name
+func ValidateHTTPHeaderName(fl validator.FieldLevel) bool {
+	return hTTPHeaderNameRegex.MatchString(fl.Field().String())
+}
+
+// ValidateRegex checks whether the field is a valid regular expression of the Go regexp syntax
+func ValidateRegex(fl validator.FieldLevel) bool {
+	_, err := regexp.Compile(fl.Field().String())
+	return err == nil
+}